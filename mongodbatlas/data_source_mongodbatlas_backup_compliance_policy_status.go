@@ -0,0 +1,155 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mwielbut/pointy"
+	matlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+func dataSourceMongoDBAtlasBackupCompliancePolicyStatus() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceMongoDBAtlasBackupCompliancePolicyStatusRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_user": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"authorized_email": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"copy_protection_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"encryption_at_rest_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"pit_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"restore_window_days": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"minimum_retention_days": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"on_demand": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"hourly": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"daily": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"weekly": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"monthly": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMongoDBAtlasBackupCompliancePolicyStatusRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*MongoDBClient).Atlas
+	projectID := d.Get("project_id").(string)
+
+	compliancePolicy, resp, err := conn.BackupCompliancePolicy.Get(ctx, projectID)
+	if err != nil && (resp == nil || resp.StatusCode != http.StatusNotFound) {
+		return diag.FromErr(fmt.Errorf(errorBackupPolicyRead, projectID, err))
+	}
+	if compliancePolicy == nil {
+		compliancePolicy = &matlas.BackupCompliancePolicy{}
+	}
+
+	values := map[string]interface{}{
+		"enabled":                    compliancePolicy.ProjectID != "",
+		"state":                      compliancePolicy.State,
+		"updated_date":               compliancePolicy.UpdatedDate,
+		"updated_user":               compliancePolicy.UpdatedUser,
+		"authorized_email":           compliancePolicy.AuthorizedEmail,
+		"copy_protection_enabled":    pointy.BoolValue(compliancePolicy.CopyProtectionEnabled, false),
+		"encryption_at_rest_enabled": pointy.BoolValue(compliancePolicy.EncryptionAtRestEnabled, false),
+		"pit_enabled":                pointy.BoolValue(compliancePolicy.PitEnabled, false),
+		"restore_window_days":        pointy.Int64Value(compliancePolicy.RestoreWindowDays, 0),
+		"minimum_retention_days":     flattenBackupCompliancePolicyMinimumRetention(compliancePolicy),
+	}
+
+	for attr, value := range values {
+		if err := d.Set(attr, value); err != nil {
+			return diag.FromErr(fmt.Errorf(errorBackupPolicySetting, attr, projectID, err))
+		}
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"project_id": projectID,
+	}))
+
+	return nil
+}
+
+// flattenBackupCompliancePolicyMinimumRetention returns, for every frequency, the retention in days that the
+// snapshots must keep at least to meet the policy, or 0 if the policy has no item with that frequency.
+func flattenBackupCompliancePolicyMinimumRetention(compliancePolicy *matlas.BackupCompliancePolicy) []map[string]interface{} {
+	if compliancePolicy.ProjectID == "" {
+		return nil
+	}
+
+	minimumRetention := map[string]interface{}{
+		"on_demand": 0,
+		"hourly":    0,
+		"daily":     0,
+		"weekly":    0,
+		"monthly":   0,
+	}
+
+	if item := compliancePolicy.OnDemandPolicyItem; item.RetentionUnit != "" {
+		minimumRetention["on_demand"] = policyItemRetentionDays(item.RetentionUnit, item.RetentionValue)
+	}
+
+	for _, item := range compliancePolicy.ScheduledPolicyItems {
+		retentionDays := policyItemRetentionDays(item.RetentionUnit, item.RetentionValue)
+		if current, ok := minimumRetention[item.FrequencyType].(int); ok && retentionDays > current {
+			minimumRetention[item.FrequencyType] = retentionDays
+		}
+	}
+
+	return []map[string]interface{}{minimumRetention}
+}
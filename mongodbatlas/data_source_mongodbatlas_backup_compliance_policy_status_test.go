@@ -0,0 +1,96 @@
+package mongodbatlas
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	matlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+func TestAccGenericBackupDSBackupCompliancePolicyStatus_basic(t *testing.T) {
+	var (
+		dataSourceName = "data.mongodbatlas_backup_compliance_policy_status.test"
+		projectName    = fmt.Sprintf("testacc-project-%s", acctest.RandString(10))
+		orgID          = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		projectOwnerID = os.Getenv("MONGODB_ATLAS_PROJECT_OWNER_ID")
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasDataSourceBackupCompliancePolicyStatusConfig(projectName, orgID, projectOwnerID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "state", "ACTIVE"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "updated_user"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "updated_date"),
+					resource.TestCheckResourceAttr(dataSourceName, "restore_window_days", "7"),
+					resource.TestCheckResourceAttr(dataSourceName, "minimum_retention_days.0.on_demand", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "minimum_retention_days.0.hourly", "7"),
+					resource.TestCheckResourceAttr(dataSourceName, "minimum_retention_days.0.daily", "7"),
+					resource.TestCheckResourceAttr(dataSourceName, "minimum_retention_days.0.weekly", "28"),
+					resource.TestCheckResourceAttr(dataSourceName, "minimum_retention_days.0.monthly", "372"),
+				),
+			},
+		},
+	})
+}
+
+func TestFlattenBackupCompliancePolicyMinimumRetention(t *testing.T) {
+	testCases := []struct {
+		name     string
+		policy   *matlas.BackupCompliancePolicy
+		expected []map[string]interface{}
+	}{
+		{
+			name:   "no policy",
+			policy: &matlas.BackupCompliancePolicy{},
+		},
+		{
+			name: "on demand only",
+			policy: &matlas.BackupCompliancePolicy{
+				ProjectID:          "project",
+				OnDemandPolicyItem: matlas.PolicyItem{FrequencyType: "ondemand", RetentionUnit: "weeks", RetentionValue: 2},
+			},
+			expected: []map[string]interface{}{
+				{"on_demand": 14, "hourly": 0, "daily": 0, "weekly": 0, "monthly": 0},
+			},
+		},
+		{
+			name: "highest retention per frequency",
+			policy: &matlas.BackupCompliancePolicy{
+				ProjectID: "project",
+				ScheduledPolicyItems: []matlas.ScheduledPolicyItem{
+					{FrequencyType: "daily", RetentionUnit: "days", RetentionValue: 7},
+					{FrequencyType: "daily", RetentionUnit: "weeks", RetentionValue: 2},
+					{FrequencyType: "monthly", RetentionUnit: "years", RetentionValue: 1},
+				},
+			},
+			expected: []map[string]interface{}{
+				{"on_demand": 0, "hourly": 0, "daily": 14, "weekly": 0, "monthly": 365},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := flattenBackupCompliancePolicyMinimumRetention(tc.policy); !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("flattenBackupCompliancePolicyMinimumRetention() = %v, expected %v", got, tc.expected)
+			}
+		})
+	}
+}
+
+func testAccMongoDBAtlasDataSourceBackupCompliancePolicyStatusConfig(projectName, orgID, projectOwnerID string) string {
+	return testAccMongoDBAtlasBackupCompliancePolicyConfig(projectName, orgID, projectOwnerID) + `
+		data "mongodbatlas_backup_compliance_policy_status" "test" {
+			project_id = mongodbatlas_backup_compliance_policy.backup_policy_res.project_id
+		}
+	`
+}
@@ -3,17 +3,24 @@ package mongodbatlas
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	matlas "go.mongodb.org/atlas/mongodbatlas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/util"
+	atlasSDK "go.mongodb.org/atlas-sdk/v20230201006/admin"
 )
 
+const snapshotFilterItemsPerPage = 500
+
 func dataSourceMongoDBAtlasCloudBackupSnapshots() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceMongoDBAtlasCloudBackupSnapshotsRead,
-		Schema: map[string]*schema.Schema{
+		Schema: withSnapshotFiltersSchema(map[string]*schema.Schema{
 			"project_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -63,6 +70,17 @@ func dataSourceMongoDBAtlasCloudBackupSnapshots() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"frequency_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"policy_items": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
 						"status": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -117,33 +135,62 @@ func dataSourceMongoDBAtlasCloudBackupSnapshots() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
-		},
+		}, "snapshot_type", "frequency_type", "status", "policy_item_id"),
 	}
 }
 
 func dataSourceMongoDBAtlasCloudBackupSnapshotsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Get client connection.
-	conn := meta.(*MongoDBClient).Atlas
+	conn := meta.(*MongoDBClient).AtlasV2
+	projectID := d.Get("project_id").(string)
+	clusterName := d.Get("cluster_name").(string)
+
+	filter, err := newSnapshotFilter(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-	requestParameters := &matlas.SnapshotReqPathParameters{
-		GroupID:     d.Get("project_id").(string),
-		ClusterName: d.Get("cluster_name").(string),
+	snapshots, totalCount, err := listSnapshotPages(d, filter, func(pageNum, itemsPerPage int) ([]atlasSDK.DiskBackupReplicaSet, int, error) {
+		request := conn.CloudBackupsApi.ListReplicaSetBackups(ctx, projectID, clusterName)
+		if pageNum > 0 {
+			request = request.PageNum(pageNum)
+		}
+		if itemsPerPage > 0 {
+			request = request.ItemsPerPage(itemsPerPage)
+		}
+		page, _, err := request.Execute()
+		if err != nil {
+			return nil, 0, err
+		}
+		return page.Results, page.GetTotalCount(), nil
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error getting cloudProviderSnapshots information: %s", err))
 	}
-	options := &matlas.ListOptions{
-		PageNum:      d.Get("page_num").(int),
-		ItemsPerPage: d.Get("items_per_page").(int),
+
+	snapshots = filterSnapshots(snapshots, filter, func(snapshot *atlasSDK.DiskBackupReplicaSet) snapshotFilterItem {
+		return snapshotFilterItem{
+			createdAt:     snapshot.CreatedAt,
+			snapshotType:  snapshot.GetSnapshotType(),
+			frequencyType: snapshot.GetFrequencyType(),
+			status:        snapshot.GetStatus(),
+			policyItems:   snapshot.PolicyItems,
+		}
+	})
+	if filter != nil {
+		totalCount = len(snapshots)
 	}
 
-	cloudProviderSnapshots, _, err := conn.CloudProviderSnapshots.GetAllCloudProviderSnapshots(ctx, requestParameters, options)
+	shardedSnapshots, err := getShardedClusterSnapshots(ctx, conn, projectID, clusterName, snapshots)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error getting cloudProviderSnapshots information: %s", err))
 	}
 
-	if err := d.Set("results", flattenCloudBackupSnapshots(cloudProviderSnapshots.Results)); err != nil {
+	if err := d.Set("results", flattenCloudBackupSnapshots(snapshots, shardedSnapshots)); err != nil {
 		return diag.FromErr(fmt.Errorf("error setting `results`: %s", err))
 	}
 
-	if err := d.Set("total_count", cloudProviderSnapshots.TotalCount); err != nil {
+	if err := d.Set("total_count", totalCount); err != nil {
 		return diag.FromErr(fmt.Errorf("error setting `total_count`: %s", err))
 	}
 
@@ -152,31 +199,296 @@ func dataSourceMongoDBAtlasCloudBackupSnapshotsRead(ctx context.Context, d *sche
 	return nil
 }
 
-func flattenCloudBackupSnapshots(cloudProviderSnapshots []*matlas.CloudProviderSnapshot) []map[string]interface{} {
+// getShardedClusterSnapshots returns the sharded cluster snapshots of the given snapshots, since the replica set
+// snapshots API doesn't return their members and snapshot IDs. The snapshots missing from the list of sharded cluster
+// snapshots are read one by one.
+func getShardedClusterSnapshots(ctx context.Context, conn *atlasSDK.APIClient, projectID, clusterName string, snapshots []atlasSDK.DiskBackupReplicaSet) ([]atlasSDK.DiskBackupShardedClusterSnapshot, error) {
+	var shardedIDs []string
+	for i := range snapshots {
+		if !strings.EqualFold(snapshots[i].GetType(), "replicaSet") {
+			shardedIDs = append(shardedIDs, snapshots[i].GetId())
+		}
+	}
+	if len(shardedIDs) == 0 {
+		return nil, nil
+	}
+
+	page, _, err := conn.CloudBackupsApi.ListShardedClusterBackups(ctx, projectID, clusterName).Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	shardedSnapshots := page.Results
+	listed := make(map[string]bool, len(shardedSnapshots))
+	for i := range shardedSnapshots {
+		listed[shardedSnapshots[i].GetId()] = true
+	}
+
+	for _, snapshotID := range shardedIDs {
+		if listed[snapshotID] {
+			continue
+		}
+		snapshot, _, err := conn.CloudBackupsApi.GetShardedClusterBackup(ctx, projectID, clusterName, snapshotID).Execute()
+		if err != nil {
+			return nil, err
+		}
+		shardedSnapshots = append(shardedSnapshots, *snapshot)
+	}
+
+	return shardedSnapshots, nil
+}
+
+func flattenCloudBackupSnapshots(cloudProviderSnapshots []atlasSDK.DiskBackupReplicaSet, shardedSnapshots []atlasSDK.DiskBackupShardedClusterSnapshot) []map[string]interface{} {
 	var results []map[string]interface{}
 
+	shardedSnapshotsByID := make(map[string]*atlasSDK.DiskBackupShardedClusterSnapshot, len(shardedSnapshots))
+	for i := range shardedSnapshots {
+		shardedSnapshotsByID[shardedSnapshots[i].GetId()] = &shardedSnapshots[i]
+	}
+
 	if len(cloudProviderSnapshots) > 0 {
 		results = make([]map[string]interface{}, len(cloudProviderSnapshots))
 
-		for k, cloudProviderSnapshot := range cloudProviderSnapshots {
+		for k := range cloudProviderSnapshots {
+			cloudProviderSnapshot := &cloudProviderSnapshots[k]
+			var members []map[string]interface{}
+			var snapshotIDs []string
+			if shardedSnapshot, ok := shardedSnapshotsByID[cloudProviderSnapshot.GetId()]; ok {
+				members = flattenShardedClusterSnapshotMembers(shardedSnapshot.Members)
+				snapshotIDs = shardedSnapshot.SnapshotIds
+			}
 			results[k] = map[string]interface{}{
-				"id":                 cloudProviderSnapshot.ID,
-				"created_at":         cloudProviderSnapshot.CreatedAt,
-				"description":        cloudProviderSnapshot.Description,
-				"expires_at":         cloudProviderSnapshot.ExpiresAt,
-				"master_key_uuid":    cloudProviderSnapshot.MasterKeyUUID,
-				"mongod_version":     cloudProviderSnapshot.MongodVersion,
-				"snapshot_type":      cloudProviderSnapshot.SnapshotType,
-				"status":             cloudProviderSnapshot.Status,
-				"storage_size_bytes": cloudProviderSnapshot.StorageSizeBytes,
-				"type":               cloudProviderSnapshot.Type,
-				"cloud_provider":     cloudProviderSnapshot.CloudProvider,
-				"members":            flattenCloudMembers(cloudProviderSnapshot.Members),
-				"replica_set_name":   cloudProviderSnapshot.ReplicaSetName,
-				"snapshot_ids":       cloudProviderSnapshot.SnapshotsIds,
+				"id":                 cloudProviderSnapshot.GetId(),
+				"created_at":         util.TimePtrToStringPtr(cloudProviderSnapshot.CreatedAt),
+				"description":        cloudProviderSnapshot.GetDescription(),
+				"expires_at":         util.TimePtrToStringPtr(cloudProviderSnapshot.ExpiresAt),
+				"master_key_uuid":    cloudProviderSnapshot.GetMasterKeyUUID(),
+				"mongod_version":     cloudProviderSnapshot.GetMongodVersion(),
+				"snapshot_type":      cloudProviderSnapshot.GetSnapshotType(),
+				"frequency_type":     cloudProviderSnapshot.GetFrequencyType(),
+				"policy_items":       cloudProviderSnapshot.PolicyItems,
+				"status":             cloudProviderSnapshot.GetStatus(),
+				"storage_size_bytes": cloudProviderSnapshot.GetStorageSizeBytes(),
+				"type":               cloudProviderSnapshot.GetType(),
+				"cloud_provider":     cloudProviderSnapshot.GetCloudProvider(),
+				"replica_set_name":   cloudProviderSnapshot.GetReplicaSetName(),
+				"members":            members,
+				"snapshot_ids":       snapshotIDs,
 			}
 		}
 	}
 
 	return results
 }
+
+func flattenShardedClusterSnapshotMembers(members []atlasSDK.DiskBackupShardedClusterSnapshotMember) []map[string]interface{} {
+	if len(members) == 0 {
+		return nil
+	}
+
+	results := make([]map[string]interface{}, len(members))
+	for i := range members {
+		results[i] = map[string]interface{}{
+			"cloud_provider":   members[i].CloudProvider,
+			"id":               members[i].Id,
+			"replica_set_name": members[i].ReplicaSetName,
+		}
+	}
+
+	return results
+}
+
+// snapshotFilter holds the provider-side filters shared by the snapshots data sources of dedicated, shared-tier and
+// serverless clusters. Each data source only exposes the filters that apply to its snapshots.
+type snapshotFilter struct {
+	createdAfter  *time.Time
+	createdBefore *time.Time
+	snapshotType  string
+	frequencyType string
+	status        string
+	policyItemID  string
+	mostRecent    bool
+}
+
+type snapshotFilterItem struct {
+	createdAt     *time.Time
+	snapshotType  string
+	frequencyType string
+	status        string
+	policyItems   []string
+}
+
+var snapshotFiltersSchema = map[string]*schema.Schema{
+	"snapshot_type": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringInSlice([]string{"onDemand", "scheduled", "fallback"}, true),
+	},
+	"frequency_type": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringInSlice([]string{"hourly", "daily", "weekly", "monthly", "yearly", "ondemand"}, true),
+	},
+	"status": {
+		Type:     schema.TypeString,
+		Optional: true,
+	},
+	"policy_item_id": {
+		Type:     schema.TypeString,
+		Optional: true,
+	},
+}
+
+// withSnapshotFiltersSchema adds the time window and most_recent filters, plus the given optional filters, to the
+// schema of a snapshots data source.
+func withSnapshotFiltersSchema(s map[string]*schema.Schema, filters ...string) map[string]*schema.Schema {
+	s["created_after"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.IsRFC3339Time,
+	}
+	s["created_before"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.IsRFC3339Time,
+	}
+	s["most_recent"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+	}
+	for _, filter := range filters {
+		s[filter] = snapshotFiltersSchema[filter]
+	}
+	return s
+}
+
+// newSnapshotFilter returns nil if no filter is set, so the data source keeps the pagination returned by Atlas.
+func newSnapshotFilter(d *schema.ResourceData) (*snapshotFilter, error) {
+	filter := &snapshotFilter{}
+	isSet := false
+
+	for attr, value := range map[string]*string{
+		"snapshot_type":  &filter.snapshotType,
+		"frequency_type": &filter.frequencyType,
+		"status":         &filter.status,
+		"policy_item_id": &filter.policyItemID,
+	} {
+		if v, ok := d.GetOk(attr); ok {
+			*value = v.(string)
+			isSet = true
+		}
+	}
+
+	for attr, value := range map[string]**time.Time{
+		"created_after":  &filter.createdAfter,
+		"created_before": &filter.createdBefore,
+	} {
+		if v, ok := d.GetOk(attr); ok {
+			t, err := time.Parse(time.RFC3339, v.(string))
+			if err != nil {
+				return nil, fmt.Errorf("error parsing `%s`: %s", attr, err)
+			}
+			*value = &t
+			isSet = true
+		}
+	}
+
+	if v, ok := d.GetOk("most_recent"); ok && v.(bool) {
+		filter.mostRecent = true
+		isSet = true
+	}
+
+	if !isSet {
+		return nil, nil
+	}
+	return filter, nil
+}
+
+func (f *snapshotFilter) matches(item *snapshotFilterItem) bool {
+	if f.snapshotType != "" && !strings.EqualFold(f.snapshotType, item.snapshotType) {
+		return false
+	}
+	if f.frequencyType != "" && !strings.EqualFold(f.frequencyType, item.frequencyType) {
+		return false
+	}
+	if f.status != "" && !strings.EqualFold(f.status, item.status) {
+		return false
+	}
+	if f.policyItemID != "" {
+		found := false
+		for _, policyItemID := range item.policyItems {
+			found = found || policyItemID == f.policyItemID
+		}
+		if !found {
+			return false
+		}
+	}
+	if f.createdAfter != nil && (item.createdAt == nil || !item.createdAt.After(*f.createdAfter)) {
+		return false
+	}
+	if f.createdBefore != nil && (item.createdAt == nil || !item.createdAt.Before(*f.createdBefore)) {
+		return false
+	}
+	return true
+}
+
+// listSnapshotPages returns the page requested with page_num and items_per_page, or every page if a filter is set
+// and no page was requested, so the filters apply to all the snapshots of the cluster.
+func listSnapshotPages[T any](d *schema.ResourceData, filter *snapshotFilter, listPage func(pageNum, itemsPerPage int) ([]T, int, error)) ([]T, int, error) {
+	pageNum := d.Get("page_num").(int)
+	itemsPerPage := d.Get("items_per_page").(int)
+	if filter == nil || pageNum > 0 {
+		return listPage(pageNum, itemsPerPage)
+	}
+
+	var results []T
+	for pageNum = 1; ; pageNum++ {
+		page, totalCount, err := listPage(pageNum, snapshotFilterItemsPerPage)
+		if err != nil {
+			return nil, 0, err
+		}
+		results = append(results, page...)
+		if len(page) == 0 || len(results) >= totalCount {
+			return results, totalCount, nil
+		}
+	}
+}
+
+// filterSnapshots returns the snapshots that match the filter sorted by creation time, most recent first. If the
+// filter is nil the snapshots are returned in the order of Atlas.
+func filterSnapshots[T any](snapshots []T, filter *snapshotFilter, toFilterItem func(*T) snapshotFilterItem) []T {
+	if filter == nil {
+		return snapshots
+	}
+
+	type filteredSnapshot struct {
+		item     snapshotFilterItem
+		snapshot T
+	}
+
+	filtered := make([]filteredSnapshot, 0, len(snapshots))
+	for i := range snapshots {
+		item := toFilterItem(&snapshots[i])
+		if filter.matches(&item) {
+			filtered = append(filtered, filteredSnapshot{item: item, snapshot: snapshots[i]})
+		}
+	}
+
+	sort.SliceStable(filtered, func(i, j int) bool {
+		a, b := filtered[i].item.createdAt, filtered[j].item.createdAt
+		if a == nil || b == nil {
+			return b == nil && a != nil
+		}
+		return a.After(*b)
+	})
+
+	if filter.mostRecent && len(filtered) > 1 {
+		filtered = filtered[:1]
+	}
+
+	results := make([]T, len(filtered))
+	for i := range filtered {
+		results[i] = filtered[i].snapshot
+	}
+	return results
+}
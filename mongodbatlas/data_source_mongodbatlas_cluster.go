@@ -132,6 +132,7 @@ func dataSourceMongoDBAtlasCluster() *schema.Resource {
 								},
 							},
 						},
+						"private_endpoint_by_region": privateEndpointByRegionSchema(),
 					},
 				},
 			},
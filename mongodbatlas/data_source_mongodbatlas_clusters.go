@@ -139,6 +139,7 @@ func dataSourceMongoDBAtlasClusters() *schema.Resource {
 											},
 										},
 									},
+									"private_endpoint_by_region": privateEndpointByRegionSchema(),
 								},
 							},
 						},
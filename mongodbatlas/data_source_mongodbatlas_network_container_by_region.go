@@ -0,0 +1,197 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	matlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+const errorContainerByRegionRead = "error reading MongoDB Network Peering Container of %s region (%s): %s"
+
+func dataSourceMongoDBAtlasNetworkContainerByRegion() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceMongoDBAtlasNetworkContainerByRegionRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"provider_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"AWS", "GCP", "AZURE"}, false),
+			},
+			"region_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"container_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"atlas_cidr_block": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"azure_subscription_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"provisioned": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"gcp_project_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"network_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vnet_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"regions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+	}
+}
+
+func dataSourceMongoDBAtlasNetworkContainerByRegionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*MongoDBClient).Atlas
+	projectID := d.Get("project_id").(string)
+	providerName := d.Get("provider_name").(string)
+	regionName := d.Get("region_name").(string)
+
+	// the cluster_id is unknown until the cluster is created, so the container is read after the cluster exists
+	// without depending on every change of the cluster
+	if clusterID := d.Get("cluster_id").(string); clusterID != "" && regionName == "" {
+		clusters, _, err := conn.AdvancedClusters.List(ctx, projectID, &matlas.ListOptions{ItemsPerPage: 500})
+		if err != nil {
+			return diag.FromErr(fmt.Errorf(errorContainerByRegionRead, providerName, regionName, err))
+		}
+
+		cluster := findAdvancedClusterByID(clusters.Results, clusterID)
+		if cluster == nil {
+			return diag.Errorf("cluster (%s) not found in project (%s)", clusterID, projectID)
+		}
+
+		regionName = advancedClusterRegionOfProvider(cluster, providerName)
+		if regionName == "" {
+			return diag.Errorf("cluster (%s) has no region in %s", clusterID, providerName)
+		}
+	}
+
+	if regionName == "" && providerName != "GCP" {
+		return diag.Errorf("`region_name` or `cluster_id` must be set when `provider_name` is %s", providerName)
+	}
+
+	// the container of a region is created with the first cluster of the region, some time after the cluster
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{"PENDING"},
+		Target:     []string{"FOUND"},
+		Refresh:    networkContainerByRegionRefreshFunc(ctx, conn, projectID, providerName, regionName),
+		Timeout:    d.Timeout(schema.TimeoutRead),
+		MinTimeout: 10 * time.Second,
+	}
+
+	result, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf(errorContainerByRegionRead, providerName, regionName, err))
+	}
+
+	container := result.(*matlas.Container)
+
+	values := map[string]interface{}{
+		"container_id":          container.ID,
+		"atlas_cidr_block":      container.AtlasCIDRBlock,
+		"region":                container.Region,
+		"azure_subscription_id": container.AzureSubscriptionID,
+		"provisioned":           container.Provisioned,
+		"gcp_project_id":        container.GCPProjectID,
+		"network_name":          container.NetworkName,
+		"vpc_id":                container.VPCID,
+		"vnet_name":             container.VNetName,
+		"regions":               container.Regions,
+	}
+
+	for attr, value := range values {
+		if err := d.Set(attr, value); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting `%s` for Network Container (%s): %s", attr, container.ID, err))
+		}
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"project_id":   projectID,
+		"container_id": container.ID,
+	}))
+
+	return nil
+}
+
+func networkContainerByRegionRefreshFunc(ctx context.Context, client *matlas.Client, projectID, providerName, regionName string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		containers, _, err := client.Containers.ListAll(ctx, projectID, nil)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if container := findNetworkContainer(containers, providerName, regionName); container != nil {
+			return container, "FOUND", nil
+		}
+
+		return "", "PENDING", nil
+	}
+}
+
+func findAdvancedClusterByID(clusters []*matlas.AdvancedCluster, clusterID string) *matlas.AdvancedCluster {
+	for _, cluster := range clusters {
+		if cluster != nil && cluster.ID == clusterID {
+			return cluster
+		}
+	}
+	return nil
+}
+
+// advancedClusterRegionOfProvider returns the first region of the cluster in the cloud provider.
+func advancedClusterRegionOfProvider(cluster *matlas.AdvancedCluster, providerName string) string {
+	for _, spec := range cluster.ReplicationSpecs {
+		if spec == nil {
+			continue
+		}
+		for _, regionConfig := range spec.RegionConfigs {
+			if regionConfig != nil && regionConfig.ProviderName == providerName {
+				return regionConfig.RegionName
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,164 @@
+package mongodbatlas
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"net"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/spf13/cast"
+	matlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+func dataSourceMongoDBAtlasNetworkContainerFreeCIDRBlocks() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceMongoDBAtlasNetworkContainerFreeCIDRBlocksRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"provider_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "AWS",
+				ValidateFunc: validation.StringInSlice([]string{"AWS", "GCP", "AZURE"}, false),
+			},
+			"regional": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"prefix_length": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"number_of_blocks": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 20),
+			},
+			"excluded_cidr_blocks": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsCIDR,
+				},
+			},
+			"cidr_blocks": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMongoDBAtlasNetworkContainerFreeCIDRBlocksRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*MongoDBClient).Atlas
+	projectID := d.Get("project_id").(string)
+	providerName := d.Get("provider_name").(string)
+
+	// the default is the largest block allowed for AWS and Azure, and the smallest block allowed for GCP
+	prefixLength := 21
+	if providerName == "GCP" {
+		prefixLength = 18
+	}
+	if v, ok := d.GetOk("prefix_length"); ok {
+		prefixLength = v.(int)
+	}
+
+	// GCP containers limited to some regions allow smaller blocks, like the container resource when `regions` is set
+	regional := d.Get("regional").(bool)
+	minPrefixLength, maxPrefixLength := containerCIDRPrefixLengths(providerName, regional)
+	if prefixLength < minPrefixLength || prefixLength > maxPrefixLength {
+		return diag.Errorf("`prefix_length` must be between %d and %d for %s containers", minPrefixLength, maxPrefixLength, providerName)
+	}
+
+	usedCIDRBlocks := cast.ToStringSlice(d.Get("excluded_cidr_blocks"))
+
+	containers, _, err := conn.Containers.ListAll(ctx, projectID, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error getting network peering containers information: %s", err))
+	}
+	for i := range containers {
+		usedCIDRBlocks = append(usedCIDRBlocks, containers[i].AtlasCIDRBlock)
+	}
+
+	peers, _, err := conn.Peers.List(ctx, projectID, &matlas.ContainersListOptions{ProviderName: "AWS"})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error getting network peering connections information: %s", err))
+	}
+	for i := range peers {
+		usedCIDRBlocks = append(usedCIDRBlocks, peers[i].RouteTableCIDRBlock)
+	}
+
+	cidrBlocks := findFreeCIDRBlocks(prefixLength, d.Get("number_of_blocks").(int), usedCIDRBlocks)
+	if len(cidrBlocks) == 0 {
+		return diag.Errorf("there isn't any free /%d CIDR block in the private ranges %v for project (%s)", prefixLength, privateCIDRBlocks, projectID)
+	}
+
+	if err := d.Set("prefix_length", prefixLength); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting `prefix_length` for network container free CIDR blocks: %s", err))
+	}
+
+	if err := d.Set("cidr_blocks", cidrBlocks); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting `cidr_blocks` for network container free CIDR blocks: %s", err))
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"project_id":    projectID,
+		"provider_name": providerName,
+	}))
+
+	return nil
+}
+
+// findFreeCIDRBlocks returns up to count CIDR blocks with the prefix length, from the lowest address of the RFC 1918
+// ranges, that don't overlap any of the used CIDR blocks.
+func findFreeCIDRBlocks(prefixLength, count int, usedCIDRBlocks []string) []string {
+	var freeCIDRBlocks []string
+	blockSize := uint32(1) << (32 - prefixLength)
+
+	for _, privateCIDRBlock := range privateCIDRBlocks {
+		_, privateNet, _ := net.ParseCIDR(privateCIDRBlock)
+		first := binary.BigEndian.Uint32(privateNet.IP.To4())
+		ones, _ := privateNet.Mask.Size()
+		// a block larger than the private range can't fit in it
+		if ones > prefixLength {
+			continue
+		}
+		last := first + (uint32(1) << (32 - ones)) - 1
+
+		for start := first; start <= last-blockSize+1 && start >= first; start += blockSize {
+			ip := make(net.IP, net.IPv4len)
+			binary.BigEndian.PutUint32(ip, start)
+			candidate := fmt.Sprintf("%s/%d", ip, prefixLength)
+
+			overlaps := false
+			for _, used := range usedCIDRBlocks {
+				if cidrBlocksOverlap(candidate, used) {
+					overlaps = true
+					break
+				}
+			}
+			if overlaps {
+				continue
+			}
+
+			freeCIDRBlocks = append(freeCIDRBlocks, candidate)
+			if len(freeCIDRBlocks) == count {
+				return freeCIDRBlocks
+			}
+		}
+	}
+
+	return freeCIDRBlocks
+}
@@ -0,0 +1,280 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	matlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+const errorPrivateEndpointConnectionStringRead = "error reading private endpoint connection string of cluster (%s): %s"
+
+func dataSourceMongoDBAtlasPrivateEndpointConnectionString() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceMongoDBAtlasPrivateEndpointConnectionStringRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"cluster_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"endpoint_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"endpoint_id", "region"},
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"provider_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"AWS", "AZURE", "GCP"}, false),
+			},
+			"connection_string": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"srv_connection_string": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"srv_shard_optimized_connection_string": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"endpoint_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"hosts": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"hostname": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"hostnames": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+	}
+}
+
+func dataSourceMongoDBAtlasPrivateEndpointConnectionStringRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*MongoDBClient).Atlas
+	projectID := d.Get("project_id").(string)
+	clusterName := d.Get("cluster_name").(string)
+	filter := privateEndpointConnectionStringFilter{
+		endpointID:   d.Get("endpoint_id").(string),
+		region:       d.Get("region").(string),
+		providerName: d.Get("provider_name").(string),
+	}
+
+	// the endpoint is added to the connection strings of the cluster some time after it becomes AVAILABLE
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{"PENDING"},
+		Target:     []string{"FOUND"},
+		Refresh:    privateEndpointConnectionStringRefreshFunc(ctx, conn, projectID, clusterName, filter),
+		Timeout:    d.Timeout(schema.TimeoutRead),
+		MinTimeout: 10 * time.Second,
+	}
+
+	result, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf(errorPrivateEndpointConnectionStringRead, clusterName, err))
+	}
+
+	privateEndpoint := result.(*matlas.PrivateEndpoint)
+	matchedEndpoints := filter.matchingEndpoints(privateEndpoint)
+	endpointIDs := make([]string, len(matchedEndpoints))
+	for i := range matchedEndpoints {
+		endpointIDs[i] = matchedEndpoints[i].EndpointID
+	}
+
+	hosts := privateEndpointConnectionStringHosts(privateEndpoint.ConnectionString)
+
+	values := map[string]interface{}{
+		"connection_string":                     privateEndpoint.ConnectionString,
+		"srv_connection_string":                 privateEndpoint.SRVConnectionString,
+		"srv_shard_optimized_connection_string": privateEndpoint.SRVShardOptimizedConnectionString,
+		"type":                                  privateEndpoint.Type,
+		"endpoint_ids":                          endpointIDs,
+		"endpoint_id":                           matchedEndpoints[0].EndpointID,
+		"region":                                matchedEndpoints[0].Region,
+		"provider_name":                         matchedEndpoints[0].ProviderName,
+		"hosts":                                 flattenPrivateEndpointConnectionStringHosts(hosts),
+		"hostnames":                             privateEndpointConnectionStringHostnames(hosts),
+	}
+
+	for attr, value := range values {
+		if err := d.Set(attr, value); err != nil {
+			return diag.FromErr(fmt.Errorf(errorClusterAdvancedSetting, attr, clusterName, err))
+		}
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"project_id":   projectID,
+		"cluster_name": clusterName,
+		"endpoint_id":  filter.endpointID,
+		"region":       filter.region,
+	}))
+
+	return nil
+}
+
+func privateEndpointConnectionStringRefreshFunc(ctx context.Context, client *matlas.Client, projectID, clusterName string, filter privateEndpointConnectionStringFilter) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		cluster, _, err := client.AdvancedClusters.Get(ctx, projectID, clusterName)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if cluster.ConnectionStrings != nil {
+			if privateEndpoint := filter.find(cluster.ConnectionStrings.PrivateEndpoint); privateEndpoint != nil {
+				return privateEndpoint, "FOUND", nil
+			}
+		}
+
+		return "", "PENDING", nil
+	}
+}
+
+// privateEndpointConnectionStringFilter selects the private endpoint connection string of a cloud endpoint, or of
+// the endpoints of a region.
+type privateEndpointConnectionStringFilter struct {
+	endpointID   string
+	region       string
+	providerName string
+}
+
+func (f privateEndpointConnectionStringFilter) find(privateEndpoints []matlas.PrivateEndpoint) *matlas.PrivateEndpoint {
+	for i := range privateEndpoints {
+		if len(f.matchingEndpoints(&privateEndpoints[i])) > 0 {
+			return &privateEndpoints[i]
+		}
+	}
+
+	return nil
+}
+
+func (f privateEndpointConnectionStringFilter) matchingEndpoints(privateEndpoint *matlas.PrivateEndpoint) []matlas.Endpoint {
+	var endpoints []matlas.Endpoint
+	for _, endpoint := range privateEndpoint.Endpoints {
+		if f.endpointID != "" && endpoint.EndpointID != f.endpointID {
+			continue
+		}
+		if f.region != "" && normalizeRegionName(endpoint.Region) != normalizeRegionName(f.region) {
+			continue
+		}
+		if f.providerName != "" && !strings.EqualFold(endpoint.ProviderName, f.providerName) {
+			continue
+		}
+		endpoints = append(endpoints, endpoint)
+	}
+
+	return endpoints
+}
+
+// normalizeRegionName allows to compare the Atlas and the cloud provider names of a region, like US_EAST_1 and us-east-1.
+func normalizeRegionName(region string) string {
+	return strings.ReplaceAll(strings.ToUpper(region), "-", "_")
+}
+
+type privateEndpointConnectionStringHost struct {
+	hostname string
+	port     string
+}
+
+// privateEndpointConnectionStringHosts returns the hosts of a mongodb:// connection string, so that DNS records can be
+// created for them when the cloud provider doesn't resolve the private endpoint hostnames.
+func privateEndpointConnectionStringHosts(connectionString string) []privateEndpointConnectionStringHost {
+	hostList := strings.TrimPrefix(connectionString, "mongodb://")
+	if i := strings.IndexAny(hostList, "/?"); i >= 0 {
+		hostList = hostList[:i]
+	}
+	if i := strings.LastIndex(hostList, "@"); i >= 0 {
+		hostList = hostList[i+1:]
+	}
+
+	var hosts []privateEndpointConnectionStringHost
+	for _, address := range strings.Split(hostList, ",") {
+		if address == "" {
+			continue
+		}
+
+		hostname, port, err := net.SplitHostPort(address)
+		if err != nil {
+			hostname, port = address, "27017"
+		}
+
+		hosts = append(hosts, privateEndpointConnectionStringHost{hostname: hostname, port: port})
+	}
+
+	return hosts
+}
+
+func flattenPrivateEndpointConnectionStringHosts(hosts []privateEndpointConnectionStringHost) []map[string]interface{} {
+	tfHosts := make([]map[string]interface{}, len(hosts))
+	for i, host := range hosts {
+		tfHosts[i] = map[string]interface{}{
+			"hostname": host.hostname,
+			"port":     host.port,
+		}
+	}
+
+	return tfHosts
+}
+
+// privateEndpointConnectionStringHostnames returns the distinct hostnames of the hosts, as Azure private endpoints
+// expose all the nodes of a cluster on the same hostname with a different port.
+func privateEndpointConnectionStringHostnames(hosts []privateEndpointConnectionStringHost) []string {
+	hostnames := make([]string, 0, len(hosts))
+	seen := make(map[string]bool, len(hosts))
+	for _, host := range hosts {
+		if seen[host.hostname] {
+			continue
+		}
+		seen[host.hostname] = true
+		hostnames = append(hostnames, host.hostname)
+	}
+
+	return hostnames
+}
@@ -0,0 +1,219 @@
+package mongodbatlas
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	matlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+func TestAccNetworkDSPrivateEndpointConnectionString_basicAWS(t *testing.T) {
+	SkipTestExtCred(t)
+	var (
+		dataSourceName = "data.mongodbatlas_private_endpoint_connection_string.test"
+
+		awsAccessKey = os.Getenv("AWS_ACCESS_KEY_ID")
+		awsSecretKey = os.Getenv("AWS_SECRET_ACCESS_KEY")
+
+		projectID       = os.Getenv("MONGODB_ATLAS_PROJECT_ID")
+		region          = os.Getenv("AWS_REGION")
+		vpcID           = os.Getenv("AWS_VPC_ID")
+		subnetID        = os.Getenv("AWS_SUBNET_ID")
+		securityGroupID = os.Getenv("AWS_SECURITY_GROUP_ID")
+		clusterName     = acctest.RandomWithPrefix("test-acc")
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t); testCheckAwsEnv(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasPrivateLinkEndpointServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasPrivateEndpointConnectionStringConfigAWS(
+					awsAccessKey, awsSecretKey, projectID, region, vpcID, subnetID, securityGroupID, clusterName,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "endpoint_id", "aws_vpc_endpoint.ptfe_service", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "provider_name", "AWS"),
+					resource.TestCheckResourceAttr(dataSourceName, "endpoint_ids.#", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "connection_string"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "srv_connection_string"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "type"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "hosts.0.hostname"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "hostnames.0"),
+				),
+			},
+		},
+	})
+}
+
+func TestPrivateEndpointConnectionStringFilter(t *testing.T) {
+	privateEndpoints := []matlas.PrivateEndpoint{
+		{
+			SRVConnectionString: "mongodb+srv://cluster-pl-0.mongodb.net",
+			Endpoints: []matlas.Endpoint{
+				{EndpointID: "vpce-1", ProviderName: "AWS", Region: "US_EAST_1"},
+			},
+		},
+		{
+			SRVConnectionString: "mongodb+srv://cluster-pl-1.mongodb.net",
+			Endpoints: []matlas.Endpoint{
+				{EndpointID: "vpce-2", ProviderName: "AWS", Region: "US_WEST_2"},
+				{EndpointID: "vpce-3", ProviderName: "AWS", Region: "US_WEST_2"},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name                string
+		filter              privateEndpointConnectionStringFilter
+		expectedSRV         string
+		expectedEndpointIDs []string
+	}{
+		{
+			name:                "endpoint id",
+			filter:              privateEndpointConnectionStringFilter{endpointID: "vpce-3"},
+			expectedSRV:         "mongodb+srv://cluster-pl-1.mongodb.net",
+			expectedEndpointIDs: []string{"vpce-3"},
+		},
+		{
+			name:                "cloud provider region name",
+			filter:              privateEndpointConnectionStringFilter{region: "us-west-2"},
+			expectedSRV:         "mongodb+srv://cluster-pl-1.mongodb.net",
+			expectedEndpointIDs: []string{"vpce-2", "vpce-3"},
+		},
+		{
+			name:                "Atlas region name and provider",
+			filter:              privateEndpointConnectionStringFilter{region: "US_EAST_1", providerName: "AWS"},
+			expectedSRV:         "mongodb+srv://cluster-pl-0.mongodb.net",
+			expectedEndpointIDs: []string{"vpce-1"},
+		},
+		{
+			name:   "other provider",
+			filter: privateEndpointConnectionStringFilter{region: "US_EAST_1", providerName: "AZURE"},
+		},
+		{
+			name:   "missing endpoint",
+			filter: privateEndpointConnectionStringFilter{endpointID: "vpce-4"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			privateEndpoint := tc.filter.find(privateEndpoints)
+			if tc.expectedSRV == "" {
+				if privateEndpoint != nil {
+					t.Errorf("expected no private endpoint, got %v", privateEndpoint)
+				}
+				return
+			}
+
+			if privateEndpoint == nil || privateEndpoint.SRVConnectionString != tc.expectedSRV {
+				t.Fatalf("expected the private endpoint with %q, got %v", tc.expectedSRV, privateEndpoint)
+			}
+
+			var endpointIDs []string
+			for _, endpoint := range tc.filter.matchingEndpoints(privateEndpoint) {
+				endpointIDs = append(endpointIDs, endpoint.EndpointID)
+			}
+			if fmt.Sprint(endpointIDs) != fmt.Sprint(tc.expectedEndpointIDs) {
+				t.Errorf("expected endpoints %v, got %v", tc.expectedEndpointIDs, endpointIDs)
+			}
+		})
+	}
+}
+
+func TestPrivateEndpointConnectionStringHosts(t *testing.T) {
+	testCases := []struct {
+		name              string
+		connectionString  string
+		expectedHosts     []privateEndpointConnectionStringHost
+		expectedHostnames []string
+	}{
+		{
+			name:             "AWS replica set",
+			connectionString: "mongodb://pl-0-us-east-1.abcde.mongodb.net:1024,pl-0-us-east-1.abcde.mongodb.net:1025,pl-0-us-east-1.abcde.mongodb.net:1026/?ssl=true&authSource=admin&replicaSet=atlas-abc-shard-0",
+			expectedHosts: []privateEndpointConnectionStringHost{
+				{hostname: "pl-0-us-east-1.abcde.mongodb.net", port: "1024"},
+				{hostname: "pl-0-us-east-1.abcde.mongodb.net", port: "1025"},
+				{hostname: "pl-0-us-east-1.abcde.mongodb.net", port: "1026"},
+			},
+			expectedHostnames: []string{"pl-0-us-east-1.abcde.mongodb.net"},
+		},
+		{
+			name:             "GCP nodes without port",
+			connectionString: "mongodb://cluster0-shard-00-00-pl-0.abcde.mongodb.net,cluster0-shard-00-01-pl-0.abcde.mongodb.net:27017?ssl=true",
+			expectedHosts: []privateEndpointConnectionStringHost{
+				{hostname: "cluster0-shard-00-00-pl-0.abcde.mongodb.net", port: "27017"},
+				{hostname: "cluster0-shard-00-01-pl-0.abcde.mongodb.net", port: "27017"},
+			},
+			expectedHostnames: []string{"cluster0-shard-00-00-pl-0.abcde.mongodb.net", "cluster0-shard-00-01-pl-0.abcde.mongodb.net"},
+		},
+		{
+			name:              "empty connection string",
+			expectedHostnames: []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			hosts := privateEndpointConnectionStringHosts(tc.connectionString)
+			if fmt.Sprint(hosts) != fmt.Sprint(tc.expectedHosts) {
+				t.Errorf("expected hosts %v, got %v", tc.expectedHosts, hosts)
+			}
+
+			hostnames := privateEndpointConnectionStringHostnames(hosts)
+			if fmt.Sprint(hostnames) != fmt.Sprint(tc.expectedHostnames) {
+				t.Errorf("expected hostnames %v, got %v", tc.expectedHostnames, hostnames)
+			}
+		})
+	}
+}
+
+func testAccMongoDBAtlasPrivateEndpointConnectionStringConfigAWS(awsAccessKey, awsSecretKey, projectID, region, vpcID, subnetID, securityGroupID, clusterName string) string {
+	return fmt.Sprintf(`
+		provider "aws" {
+			region     = %[4]q
+			access_key = %[1]q
+			secret_key = %[2]q
+		}
+
+		resource "mongodbatlas_cluster" "test" {
+			project_id                  = %[3]q
+			name                        = %[8]q
+			provider_name               = "AWS"
+			provider_region_name        = upper(replace(%[4]q, "-", "_"))
+			provider_instance_size_name = "M10"
+		}
+
+		resource "mongodbatlas_privatelink_endpoint" "test" {
+			project_id    = %[3]q
+			provider_name = "AWS"
+			region        = %[4]q
+		}
+
+		resource "aws_vpc_endpoint" "ptfe_service" {
+			vpc_id             = %[5]q
+			service_name       = mongodbatlas_privatelink_endpoint.test.endpoint_service_name
+			vpc_endpoint_type  = "Interface"
+			subnet_ids         = [%[6]q]
+			security_group_ids = [%[7]q]
+		}
+
+		resource "mongodbatlas_privatelink_endpoint_service" "test" {
+			project_id          = mongodbatlas_privatelink_endpoint.test.project_id
+			endpoint_service_id = aws_vpc_endpoint.ptfe_service.id
+			private_link_id     = mongodbatlas_privatelink_endpoint.test.id
+			provider_name       = "AWS"
+		}
+
+		data "mongodbatlas_private_endpoint_connection_string" "test" {
+			project_id   = mongodbatlas_cluster.test.project_id
+			cluster_name = mongodbatlas_cluster.test.name
+			endpoint_id  = mongodbatlas_privatelink_endpoint_service.test.endpoint_service_id
+		}
+	`, awsAccessKey, awsSecretKey, projectID, region, vpcID, subnetID, securityGroupID, clusterName)
+}
@@ -0,0 +1,103 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceMongoDBAtlasServerlessRestoreJob() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceMongoDBAtlasServerlessRestoreJobRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"instance_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"restore_job_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"snapshot_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"delivery_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"target_project_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"target_cluster_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"point_in_time_utc_seconds": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"oplog_ts": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"oplog_inc": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"cancelled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"expired": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"failed": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"expires_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"finished_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceMongoDBAtlasServerlessRestoreJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*MongoDBClient).AtlasV2
+
+	projectID := d.Get("project_id").(string)
+	instanceName := d.Get("instance_name").(string)
+	restoreJobID := d.Get("restore_job_id").(string)
+
+	job, _, err := conn.CloudBackupsApi.GetServerlessBackupRestoreJob(ctx, projectID, instanceName, restoreJobID).Execute()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error getting serverless restore job '%s': %w", restoreJobID, err))
+	}
+
+	for key, value := range flattenServerlessRestoreJob(job) {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting `%s` for serverless restore job '%s': %w", key, restoreJobID, err))
+		}
+	}
+
+	d.SetId(job.GetId())
+	return nil
+}
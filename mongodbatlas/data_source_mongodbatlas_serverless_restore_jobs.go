@@ -0,0 +1,167 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/util"
+	atlasSDK "go.mongodb.org/atlas-sdk/v20230201006/admin"
+)
+
+func dataSourceMongoDBAtlasServerlessRestoreJobs() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceMongoDBAtlasServerlessRestoreJobsRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"instance_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"page_num": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"items_per_page": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"restore_job_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"snapshot_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"delivery_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"target_project_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"target_cluster_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"point_in_time_utc_seconds": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"oplog_ts": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"oplog_inc": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"cancelled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"expired": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"failed": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"expires_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"finished_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"timestamp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"total_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceMongoDBAtlasServerlessRestoreJobsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*MongoDBClient).AtlasV2
+
+	projectID := d.Get("project_id").(string)
+	instanceName := d.Get("instance_name").(string)
+
+	request := conn.CloudBackupsApi.ListServerlessBackupRestoreJobs(ctx, projectID, instanceName)
+	if v, ok := d.GetOk("page_num"); ok {
+		request = request.PageNum(v.(int))
+	}
+	if v, ok := d.GetOk("items_per_page"); ok {
+		request = request.ItemsPerPage(v.(int))
+	}
+
+	jobs, _, err := request.Execute()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error getting serverless restore jobs for instance '%s': %w", instanceName, err))
+	}
+
+	if err := d.Set("results", flattenServerlessRestoreJobs(jobs.Results)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting `results`: %w", err))
+	}
+
+	if err := d.Set("total_count", jobs.TotalCount); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting `total_count`: %w", err))
+	}
+
+	d.SetId(id.UniqueId())
+	return nil
+}
+
+func flattenServerlessRestoreJobs(serverlessJobs []atlasSDK.ServerlessBackupRestoreJob) []map[string]interface{} {
+	if len(serverlessJobs) == 0 {
+		return nil
+	}
+
+	results := make([]map[string]interface{}, len(serverlessJobs))
+	for i := range serverlessJobs {
+		results[i] = flattenServerlessRestoreJob(&serverlessJobs[i])
+	}
+
+	return results
+}
+
+func flattenServerlessRestoreJob(serverlessJob *atlasSDK.ServerlessBackupRestoreJob) map[string]interface{} {
+	return map[string]interface{}{
+		"restore_job_id":            serverlessJob.GetId(),
+		"snapshot_id":               serverlessJob.GetSnapshotId(),
+		"delivery_type":             serverlessJob.GetDeliveryType(),
+		"target_project_id":         serverlessJob.GetTargetGroupId(),
+		"target_cluster_name":       serverlessJob.GetTargetClusterName(),
+		"point_in_time_utc_seconds": serverlessJob.GetPointInTimeUTCSeconds(),
+		"oplog_ts":                  serverlessJob.GetOplogTs(),
+		"oplog_inc":                 serverlessJob.GetOplogInc(),
+		"cancelled":                 serverlessJob.GetCancelled(),
+		"expired":                   serverlessJob.GetExpired(),
+		"failed":                    serverlessJob.GetFailed(),
+		"expires_at":                util.TimePtrToStringPtr(serverlessJob.ExpiresAt),
+		"finished_at":               util.TimePtrToStringPtr(serverlessJob.FinishedAt),
+		"timestamp":                 util.TimePtrToStringPtr(serverlessJob.Timestamp),
+	}
+}
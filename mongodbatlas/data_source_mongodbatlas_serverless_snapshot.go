@@ -0,0 +1,79 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceMongoDBAtlasServerlessSnapshot() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceMongoDBAtlasServerlessSnapshotRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"instance_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"snapshot_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expires_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"frequency_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"mongod_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"snapshot_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"storage_size_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceMongoDBAtlasServerlessSnapshotRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*MongoDBClient).AtlasV2
+
+	projectID := d.Get("project_id").(string)
+	instanceName := d.Get("instance_name").(string)
+	snapshotID := d.Get("snapshot_id").(string)
+
+	snapshot, _, err := conn.CloudBackupsApi.GetServerlessBackup(ctx, projectID, instanceName, snapshotID).Execute()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error getting serverless snapshot '%s': %w", snapshotID, err))
+	}
+
+	for key, value := range flattenServerlessSnapshot(snapshot) {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting `%s` for serverless snapshot '%s': %w", key, snapshotID, err))
+		}
+	}
+
+	d.SetId(snapshot.GetId())
+	return nil
+}
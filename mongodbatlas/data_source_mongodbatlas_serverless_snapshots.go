@@ -0,0 +1,159 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/util"
+	atlasSDK "go.mongodb.org/atlas-sdk/v20230201006/admin"
+)
+
+func dataSourceMongoDBAtlasServerlessSnapshots() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceMongoDBAtlasServerlessSnapshotsRead,
+		Schema: withSnapshotFiltersSchema(map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"instance_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"page_num": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"items_per_page": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"snapshot_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"expires_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"frequency_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"mongod_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"snapshot_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"storage_size_bytes": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"total_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		}, "snapshot_type", "frequency_type", "status"),
+	}
+}
+
+func dataSourceMongoDBAtlasServerlessSnapshotsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*MongoDBClient).AtlasV2
+
+	projectID := d.Get("project_id").(string)
+	instanceName := d.Get("instance_name").(string)
+
+	filter, err := newSnapshotFilter(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	snapshots, totalCount, err := listSnapshotPages(d, filter, func(pageNum, itemsPerPage int) ([]atlasSDK.ServerlessBackupSnapshot, int, error) {
+		request := conn.CloudBackupsApi.ListServerlessBackups(ctx, projectID, instanceName)
+		if pageNum > 0 {
+			request = request.PageNum(pageNum)
+		}
+		if itemsPerPage > 0 {
+			request = request.ItemsPerPage(itemsPerPage)
+		}
+		page, _, err := request.Execute()
+		if err != nil {
+			return nil, 0, err
+		}
+		return page.Results, page.GetTotalCount(), nil
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error getting serverless snapshots for instance '%s': %w", instanceName, err))
+	}
+
+	snapshots = filterSnapshots(snapshots, filter, func(snapshot *atlasSDK.ServerlessBackupSnapshot) snapshotFilterItem {
+		return snapshotFilterItem{
+			createdAt:     snapshot.CreatedAt,
+			snapshotType:  snapshot.GetSnapshotType(),
+			frequencyType: snapshot.GetFrequencyType(),
+			status:        snapshot.GetStatus(),
+		}
+	})
+	if filter != nil {
+		totalCount = len(snapshots)
+	}
+
+	if err := d.Set("results", flattenServerlessSnapshots(snapshots)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting `results`: %w", err))
+	}
+
+	if err := d.Set("total_count", totalCount); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting `total_count`: %w", err))
+	}
+
+	d.SetId(id.UniqueId())
+	return nil
+}
+
+func flattenServerlessSnapshots(serverlessSnapshots []atlasSDK.ServerlessBackupSnapshot) []map[string]interface{} {
+	if len(serverlessSnapshots) == 0 {
+		return nil
+	}
+
+	results := make([]map[string]interface{}, len(serverlessSnapshots))
+	for i := range serverlessSnapshots {
+		results[i] = flattenServerlessSnapshot(&serverlessSnapshots[i])
+	}
+
+	return results
+}
+
+func flattenServerlessSnapshot(serverlessSnapshot *atlasSDK.ServerlessBackupSnapshot) map[string]interface{} {
+	return map[string]interface{}{
+		"snapshot_id":        serverlessSnapshot.GetId(),
+		"created_at":         util.TimePtrToStringPtr(serverlessSnapshot.CreatedAt),
+		"expires_at":         util.TimePtrToStringPtr(serverlessSnapshot.ExpiresAt),
+		"frequency_type":     serverlessSnapshot.GetFrequencyType(),
+		"mongod_version":     serverlessSnapshot.GetMongodVersion(),
+		"snapshot_type":      serverlessSnapshot.GetSnapshotType(),
+		"status":             serverlessSnapshot.GetStatus(),
+		"storage_size_bytes": serverlessSnapshot.GetStorageSizeBytes(),
+	}
+}
@@ -15,7 +15,7 @@ import (
 func dataSourceMongoDBAtlasSharedTierSnapshots() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceMongoDBAtlasSharedTierSnapshotsRead,
-		Schema: map[string]*schema.Schema{
+		Schema: withSnapshotFiltersSchema(map[string]*schema.Schema{
 			"project_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -64,7 +64,7 @@ func dataSourceMongoDBAtlasSharedTierSnapshots() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
-		},
+		}, "status"),
 	}
 }
 
@@ -78,11 +78,28 @@ func dataSourceMongoDBAtlasSharedTierSnapshotsRead(ctx context.Context, d *schem
 		return diag.FromErr(fmt.Errorf("error getting shard-tier snapshots for cluster '%s': %w", clusterName, err))
 	}
 
-	if err := d.Set("results", flattenSharedTierSnapshots(snapshots.Results)); err != nil {
+	filter, err := newSnapshotFilter(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// shared-tier snapshots don't have a creation date, the time window filters use the time the snapshot started
+	results := filterSnapshots(snapshots.Results, filter, func(snapshot *atlasSDK.BackupTenantSnapshot) snapshotFilterItem {
+		return snapshotFilterItem{
+			createdAt: snapshot.StartTime,
+			status:    snapshot.GetStatus(),
+		}
+	})
+	totalCount := snapshots.GetTotalCount()
+	if filter != nil {
+		totalCount = len(results)
+	}
+
+	if err := d.Set("results", flattenSharedTierSnapshots(results)); err != nil {
 		return diag.FromErr(fmt.Errorf("error setting `results`: %w", err))
 	}
 
-	if err := d.Set("total_count", snapshots.TotalCount); err != nil {
+	if err := d.Set("total_count", totalCount); err != nil {
 		return diag.FromErr(fmt.Errorf("error setting `total_count`: %w", err))
 	}
 
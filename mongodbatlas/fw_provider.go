@@ -373,9 +373,16 @@ func (p *MongodbtlasProvider) Resources(context.Context) []func() resource.Resou
 	return []func() resource.Resource{
 		NewProjectRS,
 		NewEncryptionAtRestRS,
+		NewEncryptionAtRestPrivateEndpointRS,
 		NewDatabaseUserRS,
 		NewAlertConfigurationRS,
 		NewProjectIPAccessListRS,
+		NewTeamProjectAssignmentRS,
+		NewAtlasUserOrgRolesRS,
+		NewAtlasUserProjectRolesRS,
+		NewProjectLimitRS,
+		NewBackupRestoreDrillRS,
+		NewBackupCompliancePolicyRS,
 	}
 }
 
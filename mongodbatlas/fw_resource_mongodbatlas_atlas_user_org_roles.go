@@ -0,0 +1,255 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.mongodb.org/atlas-sdk/v20230201006/admin"
+
+	conversion "github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/framework/conversion"
+)
+
+const (
+	atlasUserOrgRolesResourceName = "atlas_user_org_roles"
+	errorAtlasUserOrgRolesUpdate  = "error updating roles of user (%s) in organization (%s): %s"
+	errorAtlasUserOrgRolesDelete  = "error resetting roles of user (%s) in organization (%s): %s"
+	atlasUserOrgMemberRole        = "ORG_MEMBER"
+)
+
+var _ resource.ResourceWithConfigure = &AtlasUserOrgRolesRS{}
+var _ resource.ResourceWithImportState = &AtlasUserOrgRolesRS{}
+
+func NewAtlasUserOrgRolesRS() resource.Resource {
+	return &AtlasUserOrgRolesRS{
+		RSCommon: RSCommon{
+			resourceName: atlasUserOrgRolesResourceName,
+		},
+	}
+}
+
+type AtlasUserOrgRolesRS struct {
+	RSCommon
+}
+
+type tfAtlasUserOrgRolesModel struct {
+	ID        types.String `tfsdk:"id"`
+	OrgID     types.String `tfsdk:"org_id"`
+	Username  types.String `tfsdk:"username"`
+	UserID    types.String `tfsdk:"user_id"`
+	RoleNames types.Set    `tfsdk:"role_names"`
+}
+
+func (r *AtlasUserOrgRolesRS) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"org_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"username": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"role_names": schema.SetAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.RegexMatches(regexp.MustCompile(`^ORG_`), "must be an organization role")),
+				},
+			},
+		},
+	}
+}
+
+func (r *AtlasUserOrgRolesRS) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan tfAtlasUserOrgRolesModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orgID := plan.OrgID.ValueString()
+	username := plan.Username.ValueString()
+
+	user, err := setAtlasUserOrgRoles(ctx, r.client.AtlasV2, orgID, username, conversion.TypesSetToString(ctx, plan.RoleNames))
+	if err != nil {
+		resp.Diagnostics.AddError("error creating user organization roles", fmt.Sprintf(errorAtlasUserOrgRolesUpdate, username, orgID, err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, newTFAtlasUserOrgRolesModel(ctx, orgID, user))...)
+}
+
+func (r *AtlasUserOrgRolesRS) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state tfAtlasUserOrgRolesModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ids := decodeStateID(state.ID.ValueString())
+	orgID := ids["org_id"]
+	username := ids["username"]
+
+	user, httpResp, err := r.client.AtlasV2.MongoDBCloudUsersApi.GetUserByUsername(ctx, username).Execute()
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("error when getting User from Atlas", fmt.Sprintf(errorUserRead, username, err))
+		return
+	}
+
+	// the user no longer belongs to the organization
+	if len(atlasUserRoleNames(user, orgID, "")) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, newTFAtlasUserOrgRolesModel(ctx, orgID, user))...)
+}
+
+func (r *AtlasUserOrgRolesRS) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan tfAtlasUserOrgRolesModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orgID := plan.OrgID.ValueString()
+	username := plan.Username.ValueString()
+
+	user, err := setAtlasUserOrgRoles(ctx, r.client.AtlasV2, orgID, username, conversion.TypesSetToString(ctx, plan.RoleNames))
+	if err != nil {
+		resp.Diagnostics.AddError("error updating user organization roles", fmt.Sprintf(errorAtlasUserOrgRolesUpdate, username, orgID, err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, newTFAtlasUserOrgRolesModel(ctx, orgID, user))...)
+}
+
+// Delete resets the user to the organization member role instead of removing the user from the organization,
+// as removing the user also revokes the access to every project of the organization.
+func (r *AtlasUserOrgRolesRS) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state tfAtlasUserOrgRolesModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orgID := state.OrgID.ValueString()
+	userID := state.UserID.ValueString()
+
+	_, httpResp, err := r.client.AtlasV2.OrganizationsApi.UpdateOrganizationRoles(ctx, orgID, userID, &admin.UpdateOrgRolesForUser{
+		OrgRoles: []string{atlasUserOrgMemberRole},
+	}).Execute()
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+			return
+		}
+		resp.Diagnostics.AddError("error deleting user organization roles", fmt.Sprintf(errorAtlasUserOrgRolesDelete, state.Username.ValueString(), orgID, err))
+	}
+}
+
+func (r *AtlasUserOrgRolesRS) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	orgID, username, err := splitAtlasUserRolesImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("import format error", fmt.Sprintf("to import user organization roles, use the format {org_id}-{username}: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), encodeStateID(map[string]string{
+		"org_id":   orgID,
+		"username": username,
+	}))...)
+}
+
+func setAtlasUserOrgRoles(ctx context.Context, connV2 *admin.APIClient, orgID, username string, roleNames []string) (*admin.CloudAppUser, error) {
+	user, _, err := connV2.MongoDBCloudUsersApi.GetUserByUsername(ctx, username).Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, _, err := connV2.OrganizationsApi.UpdateOrganizationRoles(ctx, orgID, user.GetId(), &admin.UpdateOrgRolesForUser{
+		OrgRoles: roleNames,
+	}).Execute(); err != nil {
+		return nil, err
+	}
+
+	user, _, err = connV2.MongoDBCloudUsersApi.GetUserByUsername(ctx, username).Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+func newTFAtlasUserOrgRolesModel(ctx context.Context, orgID string, user *admin.CloudAppUser) *tfAtlasUserOrgRolesModel {
+	roleNames, _ := types.SetValueFrom(ctx, types.StringType, atlasUserRoleNames(user, orgID, ""))
+
+	return &tfAtlasUserOrgRolesModel{
+		ID: types.StringValue(encodeStateID(map[string]string{
+			"org_id":   orgID,
+			"username": user.Username,
+		})),
+		OrgID:     types.StringValue(orgID),
+		Username:  types.StringValue(user.Username),
+		UserID:    types.StringPointerValue(user.Id),
+		RoleNames: roleNames,
+	}
+}
+
+// atlasUserRoleNames returns the names of the roles the user has in the given organization, or in the given project when orgID is empty.
+func atlasUserRoleNames(user *admin.CloudAppUser, orgID, projectID string) []string {
+	roleNames := []string{}
+	for _, role := range user.Roles {
+		if (orgID != "" && role.GetOrgId() == orgID) || (projectID != "" && role.GetGroupId() == projectID) {
+			roleNames = append(roleNames, role.GetRoleName())
+		}
+	}
+	return roleNames
+}
+
+// splitAtlasUserRolesImportID splits an import ID in the format {id}-{username}. Atlas IDs don't contain dashes,
+// so the username is whatever follows the first dash, even if it contains dashes itself.
+func splitAtlasUserRolesImportID(id string) (parentID, username string, err error) {
+	parts := strings.SplitN(id, "-", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid import ID %q", id)
+	}
+	return parts[0], parts[1], nil
+}
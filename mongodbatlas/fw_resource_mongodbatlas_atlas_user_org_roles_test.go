@@ -0,0 +1,68 @@
+package mongodbatlas
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccConfigRSAtlasUserOrgRoles_basic(t *testing.T) {
+	var (
+		resourceName = "mongodbatlas_atlas_user_org_roles.test"
+		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		username     = os.Getenv("MONGODB_ATLAS_USERNAME_CLOUD_DEV")
+	)
+
+	resource.Test(t, resource.TestCase{ // does not run in parallel as it modifies the roles of an existing user
+		PreCheck:                 func() { testAccPreCheckBasic(t); testAccPreCheckAtlasUsername(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasUserOrgRolesConfig(orgID, username, `["ORG_MEMBER", "ORG_READ_ONLY"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "org_id", orgID),
+					resource.TestCheckResourceAttrSet(resourceName, "user_id"),
+					resource.TestCheckResourceAttr(resourceName, "username", username),
+					resource.TestCheckResourceAttr(resourceName, "role_names.#", "2"),
+				),
+			},
+			{
+				Config: testAccMongoDBAtlasUserOrgRolesConfig(orgID, username, `["ORG_MEMBER"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "username", username),
+					resource.TestCheckResourceAttr(resourceName, "role_names.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateIdFunc: testAccCheckMongoDBAtlasUserOrgRolesImportStateIDFunc(resourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckMongoDBAtlasUserOrgRolesImportStateIDFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s-%s", rs.Primary.Attributes["org_id"], rs.Primary.Attributes["username"]), nil
+	}
+}
+
+func testAccMongoDBAtlasUserOrgRolesConfig(orgID, username, roleNames string) string {
+	return fmt.Sprintf(`
+		resource "mongodbatlas_atlas_user_org_roles" "test" {
+			org_id     = %[1]q
+			username   = %[2]q
+			role_names = %[3]s
+		}
+	`, orgID, username, roleNames)
+}
@@ -0,0 +1,266 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.mongodb.org/atlas-sdk/v20230201006/admin"
+
+	conversion "github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/framework/conversion"
+)
+
+const (
+	atlasUserProjectRolesResourceName = "atlas_user_project_roles"
+	errorAtlasUserProjectRolesUpdate  = "error updating roles of user (%s) in project (%s): %s"
+	errorAtlasUserProjectRolesDelete  = "error removing user (%s) from project (%s): %s"
+	atlasUserProjectRolesV2MediaType  = "application/vnd.atlas.2023-01-01+json"
+)
+
+var _ resource.ResourceWithConfigure = &AtlasUserProjectRolesRS{}
+var _ resource.ResourceWithImportState = &AtlasUserProjectRolesRS{}
+
+func NewAtlasUserProjectRolesRS() resource.Resource {
+	return &AtlasUserProjectRolesRS{
+		RSCommon: RSCommon{
+			resourceName: atlasUserProjectRolesResourceName,
+		},
+	}
+}
+
+type AtlasUserProjectRolesRS struct {
+	RSCommon
+}
+
+type tfAtlasUserProjectRolesModel struct {
+	ID        types.String `tfsdk:"id"`
+	ProjectID types.String `tfsdk:"project_id"`
+	Username  types.String `tfsdk:"username"`
+	UserID    types.String `tfsdk:"user_id"`
+	RoleNames types.Set    `tfsdk:"role_names"`
+}
+
+func (r *AtlasUserProjectRolesRS) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"username": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"role_names": schema.SetAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.RegexMatches(regexp.MustCompile(`^GROUP_`), "must be a project role")),
+				},
+			},
+		},
+	}
+}
+
+func (r *AtlasUserProjectRolesRS) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan tfAtlasUserProjectRolesModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := plan.ProjectID.ValueString()
+	username := plan.Username.ValueString()
+
+	user, err := addAtlasUserToProject(ctx, r.client, projectID, username, conversion.TypesSetToString(ctx, plan.RoleNames))
+	if err != nil {
+		resp.Diagnostics.AddError("error creating user project roles", fmt.Sprintf(errorAtlasUserProjectRolesUpdate, username, projectID, err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, newTFAtlasUserProjectRolesModel(ctx, projectID, user))...)
+}
+
+func (r *AtlasUserProjectRolesRS) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state tfAtlasUserProjectRolesModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ids := decodeStateID(state.ID.ValueString())
+	projectID := ids["project_id"]
+	username := ids["username"]
+
+	user, httpResp, err := r.client.AtlasV2.MongoDBCloudUsersApi.GetUserByUsername(ctx, username).Execute()
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("error when getting User from Atlas", fmt.Sprintf(errorUserRead, username, err))
+		return
+	}
+
+	// the user no longer belongs to the project
+	if len(atlasUserRoleNames(user, "", projectID)) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, newTFAtlasUserProjectRolesModel(ctx, projectID, user))...)
+}
+
+func (r *AtlasUserProjectRolesRS) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan tfAtlasUserProjectRolesModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := plan.ProjectID.ValueString()
+	username := plan.Username.ValueString()
+
+	user, err := setAtlasUserProjectRoles(ctx, r.client.AtlasV2, projectID, username, conversion.TypesSetToString(ctx, plan.RoleNames))
+	if err != nil {
+		resp.Diagnostics.AddError("error updating user project roles", fmt.Sprintf(errorAtlasUserProjectRolesUpdate, username, projectID, err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, newTFAtlasUserProjectRolesModel(ctx, projectID, user))...)
+}
+
+func (r *AtlasUserProjectRolesRS) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state tfAtlasUserProjectRolesModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := state.ProjectID.ValueString()
+	userID := state.UserID.ValueString()
+
+	httpResp, err := r.client.AtlasV2.ProjectsApi.RemoveProjectUser(ctx, projectID, userID).Execute()
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+			return
+		}
+		resp.Diagnostics.AddError("error deleting user project roles", fmt.Sprintf(errorAtlasUserProjectRolesDelete, state.Username.ValueString(), projectID, err))
+	}
+}
+
+func (r *AtlasUserProjectRolesRS) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	projectID, username, err := splitAtlasUserRolesImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("import format error", fmt.Sprintf("to import user project roles, use the format {project_id}-{username}: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), encodeStateID(map[string]string{
+		"project_id": projectID,
+		"username":   username,
+	}))...)
+}
+
+// addAtlasUserToProject adds the user to the project with the given roles. The roles of a user that is already a member
+// of the project are replaced, since the users added outside of Terraform can be managed by this resource.
+func addAtlasUserToProject(ctx context.Context, client *MongoDBClient, projectID, username string, roleNames []string) (*admin.CloudAppUser, error) {
+	user, _, err := client.AtlasV2.MongoDBCloudUsersApi.GetUserByUsername(ctx, username).Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(atlasUserRoleNames(user, "", projectID)) > 0 {
+		return setAtlasUserProjectRoles(ctx, client.AtlasV2, projectID, username, roleNames)
+	}
+
+	// UpdateProjectRoles only changes the roles of the members of the project, and the Atlas clients don't support
+	// adding a user to a project yet
+	req, err := client.Atlas.NewRequest(ctx, http.MethodPost, fmt.Sprintf("api/atlas/v2/groups/%s/users", projectID), &admin.GroupInvitationRequest{
+		Username: admin.PtrString(username),
+		Roles:    roleNames,
+	})
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", atlasUserProjectRolesV2MediaType)
+	req.Header.Set("Content-Type", atlasUserProjectRolesV2MediaType)
+
+	if _, err := client.Atlas.Do(ctx, req, nil); err != nil {
+		return nil, err
+	}
+
+	user, _, err = client.AtlasV2.MongoDBCloudUsersApi.GetUserByUsername(ctx, username).Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// setAtlasUserProjectRoles replaces the roles of a user that is already a member of the project.
+func setAtlasUserProjectRoles(ctx context.Context, connV2 *admin.APIClient, projectID, username string, roleNames []string) (*admin.CloudAppUser, error) {
+	user, _, err := connV2.MongoDBCloudUsersApi.GetUserByUsername(ctx, username).Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, _, err := connV2.ProjectsApi.UpdateProjectRoles(ctx, projectID, user.GetId(), &admin.UpdateGroupRolesForUser{
+		GroupRoles: roleNames,
+	}).Execute(); err != nil {
+		return nil, err
+	}
+
+	user, _, err = connV2.MongoDBCloudUsersApi.GetUserByUsername(ctx, username).Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+func newTFAtlasUserProjectRolesModel(ctx context.Context, projectID string, user *admin.CloudAppUser) *tfAtlasUserProjectRolesModel {
+	roleNames, _ := types.SetValueFrom(ctx, types.StringType, atlasUserRoleNames(user, "", projectID))
+
+	return &tfAtlasUserProjectRolesModel{
+		ID: types.StringValue(encodeStateID(map[string]string{
+			"project_id": projectID,
+			"username":   user.Username,
+		})),
+		ProjectID: types.StringValue(projectID),
+		Username:  types.StringValue(user.Username),
+		UserID:    types.StringPointerValue(user.Id),
+		RoleNames: roleNames,
+	}
+}
@@ -0,0 +1,97 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccConfigRSAtlasUserProjectRoles_basic(t *testing.T) {
+	var (
+		resourceName = "mongodbatlas_atlas_user_project_roles.test"
+		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		projectName  = acctest.RandomWithPrefix("test-acc")
+		username     = os.Getenv("MONGODB_ATLAS_USERNAME_CLOUD_DEV")
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t); testAccPreCheckAtlasUsername(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasUserProjectRolesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasUserProjectRolesConfig(orgID, projectName, username, `["GROUP_READ_ONLY"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "project_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "user_id"),
+					resource.TestCheckResourceAttr(resourceName, "username", username),
+					resource.TestCheckResourceAttr(resourceName, "role_names.#", "1"),
+				),
+			},
+			{
+				Config: testAccMongoDBAtlasUserProjectRolesConfig(orgID, projectName, username, `["GROUP_READ_ONLY", "GROUP_DATA_ACCESS_READ_ONLY"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "username", username),
+					resource.TestCheckResourceAttr(resourceName, "role_names.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateIdFunc: testAccCheckMongoDBAtlasUserProjectRolesImportStateIDFunc(resourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckMongoDBAtlasUserProjectRolesDestroy(s *terraform.State) error {
+	connV2 := testMongoDBClient.(*MongoDBClient).AtlasV2
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "mongodbatlas_atlas_user_project_roles" {
+			continue
+		}
+
+		user, _, err := connV2.MongoDBCloudUsersApi.GetUserByUsername(context.Background(), rs.Primary.Attributes["username"]).Execute()
+		if err != nil {
+			continue
+		}
+		if len(atlasUserRoleNames(user, "", rs.Primary.Attributes["project_id"])) > 0 {
+			return fmt.Errorf("user (%s) still has roles in project (%s)", user.Username, rs.Primary.Attributes["project_id"])
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckMongoDBAtlasUserProjectRolesImportStateIDFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s-%s", rs.Primary.Attributes["project_id"], rs.Primary.Attributes["username"]), nil
+	}
+}
+
+func testAccMongoDBAtlasUserProjectRolesConfig(orgID, projectName, username, roleNames string) string {
+	return fmt.Sprintf(`
+		resource "mongodbatlas_project" "test" {
+			name   = %[2]q
+			org_id = %[1]q
+		}
+
+		resource "mongodbatlas_atlas_user_project_roles" "test" {
+			project_id = mongodbatlas_project.test.id
+			username   = %[3]q
+			role_names = %[4]s
+		}
+	`, orgID, projectName, username, roleNames)
+}
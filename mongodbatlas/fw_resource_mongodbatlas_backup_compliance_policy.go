@@ -0,0 +1,411 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mwielbut/pointy"
+	matlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+const (
+	backupCompliancePolicyResourceName = "backup_compliance_policy"
+	backupCompliancePolicyStateActive  = "ACTIVE"
+	errorBackupPolicyUpdate            = "error updating a Backup Compliance Policy: %s: %s"
+	errorBackupPolicyRead              = "error getting a Backup Compliance Policy for the project(%s): %s"
+	errorBackupPolicySetting           = "error setting `%s` for Backup Compliance Policy : %s: %s"
+	backupCompliancePolicyDestroyWarn  = "Destroying a Backup Compliance Policy resource in Terraform only removes it from the Terraform state, " +
+		"the policy stays active in the Atlas project (%s). To disable a Backup Compliance Policy, the security or legal representative " +
+		"specified for the Backup Compliance Policy must contact MongoDB Support and complete an extensive verification process."
+)
+
+var _ resource.ResourceWithConfigure = &BackupCompliancePolicyRS{}
+var _ resource.ResourceWithImportState = &BackupCompliancePolicyRS{}
+var _ resource.ResourceWithModifyPlan = &BackupCompliancePolicyRS{}
+
+func NewBackupCompliancePolicyRS() resource.Resource {
+	return &BackupCompliancePolicyRS{
+		RSCommon: RSCommon{
+			resourceName: backupCompliancePolicyResourceName,
+		},
+	}
+}
+
+type BackupCompliancePolicyRS struct {
+	RSCommon
+}
+
+type tfBackupCompliancePolicyRSModel struct {
+	ID                      types.String                        `tfsdk:"id"`
+	ProjectID               types.String                        `tfsdk:"project_id"`
+	AuthorizedEmail         types.String                        `tfsdk:"authorized_email"`
+	CopyProtectionEnabled   types.Bool                          `tfsdk:"copy_protection_enabled"`
+	EncryptionAtRestEnabled types.Bool                          `tfsdk:"encryption_at_rest_enabled"`
+	PitEnabled              types.Bool                          `tfsdk:"pit_enabled"`
+	RestoreWindowDays       types.Int64                         `tfsdk:"restore_window_days"`
+	State                   types.String                        `tfsdk:"state"`
+	UpdatedDate             types.String                        `tfsdk:"updated_date"`
+	UpdatedUser             types.String                        `tfsdk:"updated_user"`
+	OnDemandPolicyItem      []tfBackupCompliancePolicyItemModel `tfsdk:"on_demand_policy_item"`
+	PolicyItemHourly        []tfBackupCompliancePolicyItemModel `tfsdk:"policy_item_hourly"`
+	PolicyItemDaily         []tfBackupCompliancePolicyItemModel `tfsdk:"policy_item_daily"`
+	PolicyItemWeekly        []tfBackupCompliancePolicyItemModel `tfsdk:"policy_item_weekly"`
+	PolicyItemMonthly       []tfBackupCompliancePolicyItemModel `tfsdk:"policy_item_monthly"`
+}
+
+type tfBackupCompliancePolicyItemModel struct {
+	ID                types.String `tfsdk:"id"`
+	FrequencyType     types.String `tfsdk:"frequency_type"`
+	FrequencyInterval types.Int64  `tfsdk:"frequency_interval"`
+	RetentionUnit     types.String `tfsdk:"retention_unit"`
+	RetentionValue    types.Int64  `tfsdk:"retention_value"`
+}
+
+func backupCompliancePolicyItemBlock(validators ...validator.List) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		Validators: validators,
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					Computed: true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+				"frequency_type": schema.StringAttribute{
+					Computed: true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+				"frequency_interval": schema.Int64Attribute{
+					Required: true,
+				},
+				"retention_unit": schema.StringAttribute{
+					Required: true,
+				},
+				"retention_value": schema.Int64Attribute{
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func (r *BackupCompliancePolicyRS) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"authorized_email": schema.StringAttribute{
+				Required: true,
+			},
+			"copy_protection_enabled": schema.BoolAttribute{
+				Required: true,
+			},
+			"encryption_at_rest_enabled": schema.BoolAttribute{
+				Required: true,
+			},
+			"pit_enabled": schema.BoolAttribute{
+				Required: true,
+			},
+			"restore_window_days": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"state": schema.StringAttribute{
+				Computed: true,
+			},
+			"updated_date": schema.StringAttribute{
+				Computed: true,
+			},
+			"updated_user": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"on_demand_policy_item": backupCompliancePolicyItemBlock(listvalidator.IsRequired(), listvalidator.SizeBetween(1, 1)),
+			"policy_item_hourly":    backupCompliancePolicyItemBlock(listvalidator.SizeAtMost(1)),
+			"policy_item_daily":     backupCompliancePolicyItemBlock(listvalidator.SizeAtMost(1)),
+			"policy_item_weekly":    backupCompliancePolicyItemBlock(),
+			"policy_item_monthly":   backupCompliancePolicyItemBlock(),
+		},
+	}
+}
+
+// ModifyPlan warns that destroying the resource doesn't disable the policy in Atlas, which requires a support ticket.
+func (r *BackupCompliancePolicyRS) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state tfBackupCompliancePolicyRSModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if req.Plan.Raw.IsNull() {
+		resp.Diagnostics.AddWarning("Backup Compliance Policy is not disabled on destroy", fmt.Sprintf(backupCompliancePolicyDestroyWarn, state.ProjectID.ValueString()))
+		return
+	}
+
+	var plan tfBackupCompliancePolicyRSModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.ProjectID.IsUnknown() && plan.ProjectID.ValueString() != state.ProjectID.ValueString() {
+		resp.Diagnostics.AddWarning("Backup Compliance Policy is not disabled on replacement", fmt.Sprintf(backupCompliancePolicyDestroyWarn, state.ProjectID.ValueString()))
+	}
+}
+
+func (r *BackupCompliancePolicyRS) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan tfBackupCompliancePolicyRSModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := plan.ProjectID.ValueString()
+
+	// there is not an entry point to create a backup compliance policy until it will use the update entry point
+	backupPolicy, err := updateBackupCompliancePolicy(ctx, r.client.Atlas, newBackupCompliancePolicyReq(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError("error creating Backup Compliance Policy", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, newTFBackupCompliancePolicyRSModel(projectID, backupPolicy))...)
+}
+
+func (r *BackupCompliancePolicyRS) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state tfBackupCompliancePolicyRSModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := decodeStateID(state.ID.ValueString())["project_id"]
+
+	backupPolicy, httpResp, err := r.client.Atlas.BackupCompliancePolicy.Get(ctx, projectID)
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("error getting Backup Compliance Policy", fmt.Sprintf(errorBackupPolicyRead, projectID, err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, newTFBackupCompliancePolicyRSModel(projectID, backupPolicy))...)
+}
+
+func (r *BackupCompliancePolicyRS) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan tfBackupCompliancePolicyRSModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := plan.ProjectID.ValueString()
+
+	backupPolicy, err := updateBackupCompliancePolicy(ctx, r.client.Atlas, newBackupCompliancePolicyReq(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError("error updating Backup Compliance Policy", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, newTFBackupCompliancePolicyRSModel(projectID, backupPolicy))...)
+}
+
+// Delete only removes the policy from the state, there is no API to disable a backup compliance policy.
+func (r *BackupCompliancePolicyRS) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state tfBackupCompliancePolicyRSModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	log.Printf("[WARN] "+backupCompliancePolicyDestroyWarn, state.ProjectID.ValueString())
+}
+
+func (r *BackupCompliancePolicyRS) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" || strings.Contains(req.ID, "-") {
+		resp.Diagnostics.AddError("import format error", "to import a Backup Compliance Policy use the format {project_id}")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), encodeStateID(map[string]string{
+		"project_id": req.ID,
+	}))...)
+}
+
+func updateBackupCompliancePolicy(ctx context.Context, conn *matlas.Client, backupPolicyReq *matlas.BackupCompliancePolicy) (*matlas.BackupCompliancePolicy, error) {
+	projectID := backupPolicyReq.ProjectID
+	if _, _, err := conn.BackupCompliancePolicy.Update(ctx, projectID, backupPolicyReq); err != nil {
+		return nil, fmt.Errorf(errorBackupPolicyUpdate, projectID, err)
+	}
+
+	backupPolicy, _, err := conn.BackupCompliancePolicy.Get(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf(errorBackupPolicyRead, projectID, err)
+	}
+
+	return backupPolicy, nil
+}
+
+func newBackupCompliancePolicyReq(plan *tfBackupCompliancePolicyRSModel) *matlas.BackupCompliancePolicy {
+	backupPolicyReq := &matlas.BackupCompliancePolicy{
+		ProjectID:               plan.ProjectID.ValueString(),
+		AuthorizedEmail:         plan.AuthorizedEmail.ValueString(),
+		CopyProtectionEnabled:   pointy.Bool(plan.CopyProtectionEnabled.ValueBool()),
+		EncryptionAtRestEnabled: pointy.Bool(plan.EncryptionAtRestEnabled.ValueBool()),
+		PitEnabled:              pointy.Bool(plan.PitEnabled.ValueBool()),
+	}
+
+	if !plan.RestoreWindowDays.IsUnknown() && !plan.RestoreWindowDays.IsNull() {
+		backupPolicyReq.RestoreWindowDays = pointy.Int64(plan.RestoreWindowDays.ValueInt64())
+	}
+
+	if len(plan.OnDemandPolicyItem) > 0 {
+		item := plan.OnDemandPolicyItem[0]
+		backupPolicyReq.OnDemandPolicyItem = matlas.PolicyItem{
+			ID:                item.ID.ValueString(),
+			FrequencyInterval: int(item.FrequencyInterval.ValueInt64()),
+			FrequencyType:     "ondemand",
+			RetentionUnit:     item.RetentionUnit.ValueString(),
+			RetentionValue:    int(item.RetentionValue.ValueInt64()),
+		}
+	}
+
+	for _, items := range []struct {
+		frequencyType string
+		items         []tfBackupCompliancePolicyItemModel
+	}{
+		{snapshotScheduleHourly, plan.PolicyItemHourly},
+		{snapshotScheduleDaily, plan.PolicyItemDaily},
+		{snapshotScheduleWeekly, plan.PolicyItemWeekly},
+		{snapshotScheduleMonthly, plan.PolicyItemMonthly},
+	} {
+		for _, item := range items.items {
+			backupPolicyReq.ScheduledPolicyItems = append(backupPolicyReq.ScheduledPolicyItems, matlas.ScheduledPolicyItem{
+				FrequencyType:     items.frequencyType,
+				FrequencyInterval: int(item.FrequencyInterval.ValueInt64()),
+				RetentionUnit:     item.RetentionUnit.ValueString(),
+				RetentionValue:    int(item.RetentionValue.ValueInt64()),
+			})
+		}
+	}
+
+	return backupPolicyReq
+}
+
+func newTFBackupCompliancePolicyRSModel(projectID string, backupPolicy *matlas.BackupCompliancePolicy) *tfBackupCompliancePolicyRSModel {
+	model := &tfBackupCompliancePolicyRSModel{
+		ID:                      types.StringValue(encodeStateID(map[string]string{"project_id": projectID})),
+		ProjectID:               types.StringValue(projectID),
+		AuthorizedEmail:         types.StringValue(backupPolicy.AuthorizedEmail),
+		CopyProtectionEnabled:   types.BoolValue(pointy.BoolValue(backupPolicy.CopyProtectionEnabled, false)),
+		EncryptionAtRestEnabled: types.BoolValue(pointy.BoolValue(backupPolicy.EncryptionAtRestEnabled, false)),
+		PitEnabled:              types.BoolValue(pointy.BoolValue(backupPolicy.PitEnabled, false)),
+		RestoreWindowDays:       types.Int64Value(pointy.Int64Value(backupPolicy.RestoreWindowDays, 0)),
+		State:                   types.StringValue(backupPolicy.State),
+		UpdatedDate:             types.StringValue(backupPolicy.UpdatedDate),
+		UpdatedUser:             types.StringValue(backupPolicy.UpdatedUser),
+		OnDemandPolicyItem: []tfBackupCompliancePolicyItemModel{{
+			ID:                types.StringValue(backupPolicy.OnDemandPolicyItem.ID),
+			FrequencyType:     types.StringValue(backupPolicy.OnDemandPolicyItem.FrequencyType),
+			FrequencyInterval: types.Int64Value(int64(backupPolicy.OnDemandPolicyItem.FrequencyInterval)),
+			RetentionUnit:     types.StringValue(backupPolicy.OnDemandPolicyItem.RetentionUnit),
+			RetentionValue:    types.Int64Value(int64(backupPolicy.OnDemandPolicyItem.RetentionValue)),
+		}},
+		PolicyItemHourly:  newTFBackupCompliancePolicyItemsModel(backupPolicy.ScheduledPolicyItems, snapshotScheduleHourly),
+		PolicyItemDaily:   newTFBackupCompliancePolicyItemsModel(backupPolicy.ScheduledPolicyItems, snapshotScheduleDaily),
+		PolicyItemWeekly:  newTFBackupCompliancePolicyItemsModel(backupPolicy.ScheduledPolicyItems, snapshotScheduleWeekly),
+		PolicyItemMonthly: newTFBackupCompliancePolicyItemsModel(backupPolicy.ScheduledPolicyItems, snapshotScheduleMonthly),
+	}
+
+	return model
+}
+
+func newTFBackupCompliancePolicyItemsModel(items []matlas.ScheduledPolicyItem, frequencyType string) []tfBackupCompliancePolicyItemModel {
+	policyItems := make([]tfBackupCompliancePolicyItemModel, 0)
+	for _, v := range items {
+		if frequencyType == v.FrequencyType {
+			policyItems = append(policyItems, tfBackupCompliancePolicyItemModel{
+				ID:                types.StringValue(v.ID),
+				FrequencyType:     types.StringValue(v.FrequencyType),
+				FrequencyInterval: types.Int64Value(int64(v.FrequencyInterval)),
+				RetentionUnit:     types.StringValue(v.RetentionUnit),
+				RetentionValue:    types.Int64Value(int64(v.RetentionValue)),
+			})
+		}
+	}
+
+	return policyItems
+}
+
+func flattenOnDemandBackupPolicyItem(item matlas.PolicyItem) []map[string]interface{} {
+	policyItems := make([]map[string]interface{}, 0)
+
+	policyItems = append(policyItems, map[string]interface{}{
+		"id":                 item.ID,
+		"frequency_interval": item.FrequencyInterval,
+		"frequency_type":     item.FrequencyType,
+		"retention_unit":     item.RetentionUnit,
+		"retention_value":    item.RetentionValue,
+	})
+
+	return policyItems
+}
+
+// getActiveBackupCompliancePolicy returns the backup compliance policy of the project if it's active, or nil if the
+// project doesn't have one or it can't be read with the credentials of the provider.
+func getActiveBackupCompliancePolicy(ctx context.Context, conn *matlas.Client, projectID string) (*matlas.BackupCompliancePolicy, error) {
+	compliancePolicy, resp, err := conn.BackupCompliancePolicy.Get(ctx, projectID)
+	if err != nil {
+		if resp != nil && (resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusForbidden) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if compliancePolicy == nil || compliancePolicy.ProjectID == "" || (compliancePolicy.State != "" && compliancePolicy.State != backupCompliancePolicyStateActive) {
+		return nil, nil
+	}
+
+	return compliancePolicy, nil
+}
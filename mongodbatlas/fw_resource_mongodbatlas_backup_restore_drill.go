@@ -0,0 +1,590 @@
+package mongodbatlas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	matlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+const (
+	backupRestoreDrillResourceName    = "backup_restore_drill"
+	backupRestoreDrillStatusSucceeded = "SUCCEEDED"
+	backupRestoreDrillStatusFailed    = "FAILED"
+	backupRestoreDrillCreateTimeout   = 6 * time.Hour
+	backupRestoreDrillDeleteTimeout   = 3 * time.Hour
+	backupRestoreDrillCheckTimeout    = 30 * time.Minute
+	errorBackupRestoreDrillCreate     = "error running restore drill of cluster (%s) in project (%s): %s"
+	errorBackupRestoreDrillDelete     = "error deleting scratch cluster (%s) of restore drill in project (%s): %s"
+)
+
+var _ resource.ResourceWithConfigure = &BackupRestoreDrillRS{}
+
+func NewBackupRestoreDrillRS() resource.Resource {
+	return &BackupRestoreDrillRS{
+		RSCommon: RSCommon{
+			resourceName: backupRestoreDrillResourceName,
+		},
+	}
+}
+
+type BackupRestoreDrillRS struct {
+	RSCommon
+}
+
+type tfBackupRestoreDrillModel struct {
+	ID                  types.String                     `tfsdk:"id"`
+	ProjectID           types.String                     `tfsdk:"project_id"`
+	ClusterName         types.String                     `tfsdk:"cluster_name"`
+	SnapshotID          types.String                     `tfsdk:"snapshot_id"`
+	DrillTrigger        types.String                     `tfsdk:"drill_trigger"`
+	ScratchClusterName  types.String                     `tfsdk:"scratch_cluster_name"`
+	ScratchInstanceSize types.String                     `tfsdk:"scratch_instance_size"`
+	ScratchProviderName types.String                     `tfsdk:"scratch_provider_name"`
+	ScratchRegionName   types.String                     `tfsdk:"scratch_region_name"`
+	KeepScratchCluster  types.Bool                       `tfsdk:"keep_scratch_cluster"`
+	Checks              []tfBackupRestoreDrillCheckModel `tfsdk:"check"`
+	Status              types.String                     `tfsdk:"status"`
+	FailureReason       types.String                     `tfsdk:"failure_reason"`
+	RestoreJobID        types.String                     `tfsdk:"restore_job_id"`
+	SnapshotCreatedAt   types.String                     `tfsdk:"snapshot_created_at"`
+	StartedAt           types.String                     `tfsdk:"started_at"`
+	FinishedAt          types.String                     `tfsdk:"finished_at"`
+	ElapsedSeconds      types.Int64                      `tfsdk:"elapsed_seconds"`
+	Timeouts            timeouts.Value                   `tfsdk:"timeouts"`
+}
+
+type tfBackupRestoreDrillCheckModel struct {
+	DatabaseName     types.String `tfsdk:"database_name"`
+	MinDocumentCount types.Int64  `tfsdk:"min_document_count"`
+	DocumentCount    types.Int64  `tfsdk:"document_count"`
+	Passed           types.Bool   `tfsdk:"passed"`
+}
+
+func (r *BackupRestoreDrillRS) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	computedString := func() schema.StringAttribute {
+		return schema.StringAttribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+	requiredReplaceString := func() schema.StringAttribute {
+		return schema.StringAttribute{
+			Required: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		}
+	}
+	optionalReplaceString := func() schema.StringAttribute {
+		return schema.StringAttribute{
+			Optional: true,
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplaceIfConfigured(),
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":                    computedString(),
+			"project_id":            requiredReplaceString(),
+			"cluster_name":          requiredReplaceString(),
+			"snapshot_id":           optionalReplaceString(),
+			"scratch_cluster_name":  optionalReplaceString(),
+			"scratch_provider_name": optionalReplaceString(),
+			"scratch_region_name":   optionalReplaceString(),
+			"drill_trigger": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"scratch_instance_size": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("M10"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"keep_scratch_cluster": schema.BoolAttribute{
+				Optional: true,
+			},
+			"status":              computedString(),
+			"failure_reason":      computedString(),
+			"restore_job_id":      computedString(),
+			"snapshot_created_at": computedString(),
+			"started_at":          computedString(),
+			"finished_at":         computedString(),
+			"elapsed_seconds": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"check": schema.ListNestedBlock{
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"database_name": schema.StringAttribute{
+							Required: true,
+						},
+						"min_document_count": schema.Int64Attribute{
+							Required: true,
+						},
+						"document_count": schema.Int64Attribute{
+							Computed: true,
+							PlanModifiers: []planmodifier.Int64{
+								int64planmodifier.UseStateForUnknown(),
+							},
+						},
+						"passed": schema.BoolAttribute{
+							Computed: true,
+							PlanModifiers: []planmodifier.Bool{
+								boolplanmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+				},
+			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Create runs the drill: it restores the snapshot into a scratch cluster, waits for the restore to complete, runs the
+// document-count checks and removes the scratch cluster. The results are saved even if the drill fails, so they can
+// be inspected, and the resource is tainted so the drill runs again on the next apply.
+func (r *BackupRestoreDrillRS) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan tfBackupRestoreDrillModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, backupRestoreDrillCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	conn := r.client.Atlas
+	projectID := plan.ProjectID.ValueString()
+	clusterName := plan.ClusterName.ValueString()
+	startedAt := time.Now().UTC()
+
+	plan.ID = types.StringValue(encodeStateID(map[string]string{
+		"project_id":   projectID,
+		"cluster_name": clusterName,
+		"started_at":   startedAt.Format(time.RFC3339),
+	}))
+	plan.StartedAt = types.StringValue(startedAt.Format(time.RFC3339))
+	plan.FailureReason = types.StringValue("")
+	plan.RestoreJobID = types.StringValue("")
+
+	scratchCreated, drillErr := runBackupRestoreDrill(ctx, conn, &plan)
+
+	if scratchCreated && !plan.KeepScratchCluster.ValueBool() {
+		// the scratch cluster is removed with a new context so it's not left behind when the drill times out
+		deleteCtx, deleteCancel := context.WithTimeout(context.Background(), backupRestoreDrillDeleteTimeout)
+		defer deleteCancel()
+		if err := deleteBackupRestoreDrillScratchCluster(deleteCtx, conn, projectID, plan.ScratchClusterName.ValueString()); err != nil {
+			resp.Diagnostics.AddWarning("error deleting restore drill scratch cluster",
+				fmt.Sprintf(errorBackupRestoreDrillDelete, plan.ScratchClusterName.ValueString(), projectID, err))
+		}
+	}
+
+	finishedAt := time.Now().UTC()
+	plan.FinishedAt = types.StringValue(finishedAt.Format(time.RFC3339))
+	plan.ElapsedSeconds = types.Int64Value(int64(finishedAt.Sub(startedAt).Seconds()))
+	plan.Status = types.StringValue(backupRestoreDrillStatusSucceeded)
+	for i := range plan.Checks {
+		if plan.Checks[i].DocumentCount.IsUnknown() {
+			plan.Checks[i].DocumentCount = types.Int64Null()
+		}
+		if plan.Checks[i].Passed.IsUnknown() {
+			plan.Checks[i].Passed = types.BoolValue(false)
+		}
+	}
+	for _, attr := range []*types.String{&plan.SnapshotID, &plan.SnapshotCreatedAt, &plan.ScratchClusterName, &plan.ScratchProviderName, &plan.ScratchRegionName} {
+		if attr.IsUnknown() {
+			*attr = types.StringNull()
+		}
+	}
+
+	if drillErr != nil {
+		plan.Status = types.StringValue(backupRestoreDrillStatusFailed)
+		plan.FailureReason = types.StringValue(drillErr.Error())
+		resp.Diagnostics.AddError("restore drill failed", fmt.Sprintf(errorBackupRestoreDrillCreate, clusterName, projectID, drillErr))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read keeps the results of the drill, which are a record of a past run and don't change in Atlas.
+func (r *BackupRestoreDrillRS) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state tfBackupRestoreDrillModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *BackupRestoreDrillRS) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan tfBackupRestoreDrillModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the scratch cluster when it was kept after the drill.
+func (r *BackupRestoreDrillRS) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state tfBackupRestoreDrillModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.KeepScratchCluster.ValueBool() || state.ScratchClusterName.ValueString() == "" {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, backupRestoreDrillDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	projectID := state.ProjectID.ValueString()
+	if err := deleteBackupRestoreDrillScratchCluster(ctx, r.client.Atlas, projectID, state.ScratchClusterName.ValueString()); err != nil {
+		resp.Diagnostics.AddError("error deleting restore drill", fmt.Sprintf(errorBackupRestoreDrillDelete, state.ScratchClusterName.ValueString(), projectID, err))
+	}
+}
+
+// runBackupRestoreDrill runs every step of the drill updating the model with the results, and returns whether the
+// scratch cluster was created so it can be removed even if a later step fails.
+func runBackupRestoreDrill(ctx context.Context, conn *matlas.Client, plan *tfBackupRestoreDrillModel) (scratchCreated bool, err error) {
+	projectID := plan.ProjectID.ValueString()
+	clusterName := plan.ClusterName.ValueString()
+
+	sourceCluster, _, err := conn.AdvancedClusters.Get(ctx, projectID, clusterName)
+	if err != nil {
+		return false, fmt.Errorf("error getting source cluster: %s", err)
+	}
+
+	snapshot, err := getBackupRestoreDrillSnapshot(ctx, conn, projectID, clusterName, plan.SnapshotID.ValueString())
+	if err != nil {
+		return false, err
+	}
+	plan.SnapshotID = types.StringValue(snapshot.ID)
+	plan.SnapshotCreatedAt = types.StringValue(snapshot.CreatedAt)
+
+	scratchCluster, err := newBackupRestoreDrillScratchCluster(sourceCluster, plan)
+	if err != nil {
+		return false, err
+	}
+	plan.ScratchClusterName = types.StringValue(scratchCluster.Name)
+	plan.ScratchProviderName = types.StringValue(scratchCluster.ReplicationSpecs[0].RegionConfigs[0].ProviderName)
+	plan.ScratchRegionName = types.StringValue(scratchCluster.ReplicationSpecs[0].RegionConfigs[0].RegionName)
+
+	if _, _, err := conn.AdvancedClusters.Create(ctx, projectID, scratchCluster); err != nil {
+		return false, fmt.Errorf("error creating scratch cluster (%s): %s", scratchCluster.Name, err)
+	}
+	if err := waitForBackupRestoreDrillScratchCluster(ctx, conn, projectID, scratchCluster.Name); err != nil {
+		return true, fmt.Errorf("error waiting for scratch cluster (%s) to be created: %s", scratchCluster.Name, err)
+	}
+
+	requestParameters := &matlas.SnapshotReqPathParameters{
+		GroupID:     projectID,
+		ClusterName: clusterName,
+	}
+	restoreJob, _, err := conn.CloudProviderSnapshotRestoreJobs.Create(ctx, requestParameters, &matlas.CloudProviderSnapshotRestoreJob{
+		SnapshotID:        snapshot.ID,
+		DeliveryType:      "automated",
+		TargetClusterName: scratchCluster.Name,
+		TargetGroupID:     projectID,
+	})
+	if err != nil {
+		return true, fmt.Errorf("error creating restore job: %s", err)
+	}
+	plan.RestoreJobID = types.StringValue(restoreJob.ID)
+
+	requestParameters.JobID = restoreJob.ID
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{"pending"},
+		Target:     []string{"completed"},
+		Refresh:    resourceCloudBackupSnapshotRestoreJobRefreshFunc(ctx, requestParameters, conn),
+		Timeout:    backupRestoreDrillCreateTimeout,
+		MinTimeout: 30 * time.Second,
+		Delay:      1 * time.Minute,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return true, err
+	}
+
+	// the cluster is restarted after the restore
+	if err := waitForBackupRestoreDrillScratchCluster(ctx, conn, projectID, scratchCluster.Name); err != nil {
+		return true, fmt.Errorf("error waiting for scratch cluster (%s) after the restore: %s", scratchCluster.Name, err)
+	}
+
+	return true, runBackupRestoreDrillChecks(ctx, conn, projectID, scratchCluster.Name, plan.Checks)
+}
+
+// getBackupRestoreDrillSnapshot returns the snapshot with the given ID, or the most recent completed snapshot of the cluster when it's empty.
+func getBackupRestoreDrillSnapshot(ctx context.Context, conn *matlas.Client, projectID, clusterName, snapshotID string) (*matlas.CloudProviderSnapshot, error) {
+	requestParameters := &matlas.SnapshotReqPathParameters{
+		GroupID:     projectID,
+		ClusterName: clusterName,
+		SnapshotID:  snapshotID,
+	}
+
+	if snapshotID != "" {
+		snapshot, _, err := conn.CloudProviderSnapshots.GetOneCloudProviderSnapshot(ctx, requestParameters)
+		if err != nil {
+			return nil, fmt.Errorf("error getting snapshot (%s): %s", snapshotID, err)
+		}
+		return snapshot, nil
+	}
+
+	snapshots, _, err := conn.CloudProviderSnapshots.GetAllCloudProviderSnapshots(ctx, requestParameters, &matlas.ListOptions{ItemsPerPage: 500})
+	if err != nil {
+		return nil, fmt.Errorf("error getting snapshots: %s", err)
+	}
+
+	var latest *matlas.CloudProviderSnapshot
+	for _, snapshot := range snapshots.Results {
+		if snapshot.Status != "completed" {
+			continue
+		}
+		// ISO 8601 dates in UTC can be compared as strings
+		if latest == nil || snapshot.CreatedAt > latest.CreatedAt {
+			latest = snapshot
+		}
+	}
+	if latest == nil {
+		return nil, errors.New("the cluster doesn't have any completed snapshot")
+	}
+
+	return latest, nil
+}
+
+func newBackupRestoreDrillScratchCluster(source *matlas.AdvancedCluster, plan *tfBackupRestoreDrillModel) (*matlas.AdvancedCluster, error) {
+	if source.ClusterType == "GEOSHARDED" || len(source.ReplicationSpecs) == 0 || len(source.ReplicationSpecs[0].RegionConfigs) == 0 {
+		return nil, fmt.Errorf("restore drills of %s clusters are not supported", strings.ToLower(source.ClusterType))
+	}
+
+	sourceRegion := source.ReplicationSpecs[0].RegionConfigs[0]
+	providerName := sourceRegion.ProviderName
+	if v := plan.ScratchProviderName.ValueString(); v != "" {
+		providerName = v
+	}
+	regionName := sourceRegion.RegionName
+	if v := plan.ScratchRegionName.ValueString(); v != "" {
+		regionName = v
+	}
+	name := plan.ScratchClusterName.ValueString()
+	if name == "" {
+		name = fmt.Sprintf("drill-%d", time.Now().Unix())
+	}
+
+	return &matlas.AdvancedCluster{
+		Name:                name,
+		ClusterType:         source.ClusterType,
+		MongoDBMajorVersion: source.MongoDBMajorVersion,
+		DiskSizeGB:          source.DiskSizeGB,
+		BackupEnabled:       pointer(false),
+		ReplicationSpecs: []*matlas.AdvancedReplicationSpec{
+			{
+				NumShards: source.ReplicationSpecs[0].NumShards,
+				RegionConfigs: []*matlas.AdvancedRegionConfig{
+					{
+						ProviderName: providerName,
+						RegionName:   regionName,
+						Priority:     pointer(7),
+						ElectableSpecs: &matlas.Specs{
+							InstanceSize: plan.ScratchInstanceSize.ValueString(),
+							NodeCount:    pointer(3),
+						},
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+func waitForBackupRestoreDrillScratchCluster(ctx context.Context, conn *matlas.Client, projectID, name string) error {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{"CREATING", "UPDATING", "REPAIRING", "REPEATING", "PENDING"},
+		Target:     []string{"IDLE"},
+		Refresh:    resourceClusterAdvancedRefreshFunc(ctx, name, projectID, conn),
+		Timeout:    backupRestoreDrillCreateTimeout,
+		MinTimeout: 30 * time.Second,
+		Delay:      1 * time.Minute,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func deleteBackupRestoreDrillScratchCluster(ctx context.Context, conn *matlas.Client, projectID, name string) error {
+	resp, err := conn.AdvancedClusters.Delete(ctx, projectID, name, nil)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil
+		}
+		return err
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{"IDLE", "CREATING", "UPDATING", "REPAIRING", "DELETING"},
+		Target:     []string{"DELETED"},
+		Refresh:    resourceClusterAdvancedRefreshFunc(ctx, name, projectID, conn),
+		Timeout:    backupRestoreDrillDeleteTimeout,
+		MinTimeout: 30 * time.Second,
+		Delay:      1 * time.Minute,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+// runBackupRestoreDrillChecks compares the number of documents of every database in the scratch cluster with the
+// expected minimum. The count is read from the DATABASE_OBJECT_COUNT measurement of the primaries of the cluster,
+// which Atlas collects some minutes after the cluster is available.
+func runBackupRestoreDrillChecks(ctx context.Context, conn *matlas.Client, projectID, clusterName string, checks []tfBackupRestoreDrillCheckModel) error {
+	if len(checks) == 0 {
+		return nil
+	}
+
+	primaries, err := getClusterPrimaryProcesses(ctx, conn, projectID, clusterName)
+	if err != nil {
+		return err
+	}
+
+	failedChecks := []string{}
+	for i := range checks {
+		databaseName := checks[i].DatabaseName.ValueString()
+		count, err := getDatabaseDocumentCount(ctx, conn, projectID, primaries, databaseName)
+		if err != nil {
+			return fmt.Errorf("error getting document count of database (%s): %s", databaseName, err)
+		}
+
+		checks[i].DocumentCount = types.Int64Value(count)
+		checks[i].Passed = types.BoolValue(count >= checks[i].MinDocumentCount.ValueInt64())
+		if !checks[i].Passed.ValueBool() {
+			failedChecks = append(failedChecks, fmt.Sprintf("database %s has %d documents, expected at least %d", databaseName, count, checks[i].MinDocumentCount.ValueInt64()))
+		}
+	}
+
+	if len(failedChecks) > 0 {
+		return fmt.Errorf("document-count checks failed: %s", strings.Join(failedChecks, "; "))
+	}
+	return nil
+}
+
+func getClusterPrimaryProcesses(ctx context.Context, conn *matlas.Client, projectID, clusterName string) ([]*matlas.Process, error) {
+	processes, _, err := conn.Processes.List(ctx, projectID, &matlas.ProcessesListOptions{ListOptions: matlas.ListOptions{ItemsPerPage: 500}})
+	if err != nil {
+		return nil, fmt.Errorf("error getting processes of cluster (%s): %s", clusterName, err)
+	}
+
+	primaries := []*matlas.Process{}
+	prefix := strings.ToLower(clusterName) + "-"
+	for _, process := range processes {
+		if process.TypeName == "REPLICA_PRIMARY" && strings.HasPrefix(process.UserAlias, prefix) && !strings.Contains(process.ReplicaSetName, "config") {
+			primaries = append(primaries, process)
+		}
+	}
+	if len(primaries) == 0 {
+		return nil, fmt.Errorf("primary of cluster (%s) not found", clusterName)
+	}
+
+	return primaries, nil
+}
+
+func getDatabaseDocumentCount(ctx context.Context, conn *matlas.Client, projectID string, primaries []*matlas.Process, databaseName string) (int64, error) {
+	var total int64
+
+	for _, primary := range primaries {
+		var count *float32
+		err := retry.RetryContext(ctx, backupRestoreDrillCheckTimeout, func() *retry.RetryError {
+			measurements, _, err := conn.ProcessDatabaseMeasurements.List(ctx, projectID, primary.Hostname, primary.Port, databaseName, &matlas.ProcessMeasurementListOptions{
+				Granularity: "PT1M",
+				Period:      "PT1H",
+				M:           []string{"DATABASE_OBJECT_COUNT"},
+			})
+			if err != nil {
+				return retry.NonRetryableError(err)
+			}
+
+			count = lastDatabaseMeasurementValue(measurements)
+			if count == nil {
+				log.Printf("[DEBUG] document count of database %s in %s is not available yet", databaseName, primary.Hostname)
+				return retry.RetryableError(errors.New("document count is not available yet"))
+			}
+			return nil
+		})
+		if err != nil {
+			return 0, err
+		}
+		total += int64(*count)
+	}
+
+	return total, nil
+}
+
+func lastDatabaseMeasurementValue(measurements *matlas.ProcessDatabaseMeasurements) *float32 {
+	if measurements == nil || measurements.ProcessMeasurements == nil {
+		return nil
+	}
+
+	for _, measurement := range measurements.Measurements {
+		for i := len(measurement.DataPoints) - 1; i >= 0; i-- {
+			if measurement.DataPoints[i].Value != nil {
+				return measurement.DataPoints[i].Value
+			}
+		}
+	}
+	return nil
+}
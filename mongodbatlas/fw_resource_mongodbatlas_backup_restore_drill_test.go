@@ -0,0 +1,92 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccBackupRSBackupRestoreDrill_basic(t *testing.T) {
+	var (
+		resourceName = "mongodbatlas_backup_restore_drill.test"
+		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		projectName  = acctest.RandomWithPrefix("test-acc")
+		clusterName  = acctest.RandomWithPrefix("test-acc")
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasBackupRestoreDrillDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasBackupRestoreDrillConfig(orgID, projectName, clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "status", "SUCCEEDED"),
+					resource.TestCheckResourceAttrPair(resourceName, "snapshot_id", "mongodbatlas_cloud_backup_snapshot.test", "snapshot_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "restore_job_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "scratch_cluster_name"),
+					resource.TestCheckResourceAttrSet(resourceName, "elapsed_seconds"),
+					resource.TestCheckResourceAttr(resourceName, "check.0.passed", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckMongoDBAtlasBackupRestoreDrillDestroy(s *terraform.State) error {
+	conn := testMongoDBClient.(*MongoDBClient).Atlas
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "mongodbatlas_backup_restore_drill" {
+			continue
+		}
+
+		if cluster, _, err := conn.AdvancedClusters.Get(context.Background(), rs.Primary.Attributes["project_id"], rs.Primary.Attributes["scratch_cluster_name"]); err == nil && cluster != nil {
+			return fmt.Errorf("scratch cluster (%s) of restore drill still exists", rs.Primary.Attributes["scratch_cluster_name"])
+		}
+	}
+
+	return nil
+}
+
+func testAccMongoDBAtlasBackupRestoreDrillConfig(orgID, projectName, clusterName string) string {
+	return fmt.Sprintf(`
+		resource "mongodbatlas_project" "test" {
+			name   = %[2]q
+			org_id = %[1]q
+		}
+
+		resource "mongodbatlas_cluster" "test" {
+			project_id                  = mongodbatlas_project.test.id
+			name                        = %[3]q
+			provider_name               = "AWS"
+			provider_region_name        = "US_EAST_1"
+			provider_instance_size_name = "M10"
+			cloud_backup                = true
+		}
+
+		resource "mongodbatlas_cloud_backup_snapshot" "test" {
+			project_id        = mongodbatlas_cluster.test.project_id
+			cluster_name      = mongodbatlas_cluster.test.name
+			description       = "restore drill"
+			retention_in_days = 1
+		}
+
+		resource "mongodbatlas_backup_restore_drill" "test" {
+			project_id   = mongodbatlas_cloud_backup_snapshot.test.project_id
+			cluster_name = mongodbatlas_cloud_backup_snapshot.test.cluster_name
+			snapshot_id  = mongodbatlas_cloud_backup_snapshot.test.snapshot_id
+
+			check {
+				database_name      = "admin"
+				min_document_count = 0
+			}
+		}
+	`, orgID, projectName, clusterName)
+}
@@ -33,6 +33,7 @@ const (
 	errorReadEncryptionAtRest    = "error getting Encryption At Rest: %s"
 	errorDeleteEncryptionAtRest  = "error deleting Encryption At Rest: (%s): %s"
 	errorUpdateEncryptionAtRest  = "error updating Encryption At Rest: %s"
+	encryptionAtRestV2MediaType  = "application/vnd.atlas.2023-01-01+json"
 )
 
 var _ resource.ResourceWithConfigure = &EncryptionAtRestRS{}
@@ -67,15 +68,16 @@ type tfAwsKmsConfigModel struct {
 	Enabled             types.Bool   `tfsdk:"enabled"`
 }
 type tfAzureKeyVaultConfigModel struct {
-	ClientID          types.String `tfsdk:"client_id"`
-	AzureEnvironment  types.String `tfsdk:"azure_environment"`
-	SubscriptionID    types.String `tfsdk:"subscription_id"`
-	ResourceGroupName types.String `tfsdk:"resource_group_name"`
-	KeyVaultName      types.String `tfsdk:"key_vault_name"`
-	KeyIdentifier     types.String `tfsdk:"key_identifier"`
-	Secret            types.String `tfsdk:"secret"`
-	TenantID          types.String `tfsdk:"tenant_id"`
-	Enabled           types.Bool   `tfsdk:"enabled"`
+	ClientID                 types.String `tfsdk:"client_id"`
+	AzureEnvironment         types.String `tfsdk:"azure_environment"`
+	SubscriptionID           types.String `tfsdk:"subscription_id"`
+	ResourceGroupName        types.String `tfsdk:"resource_group_name"`
+	KeyVaultName             types.String `tfsdk:"key_vault_name"`
+	KeyIdentifier            types.String `tfsdk:"key_identifier"`
+	Secret                   types.String `tfsdk:"secret"`
+	TenantID                 types.String `tfsdk:"tenant_id"`
+	Enabled                  types.Bool   `tfsdk:"enabled"`
+	RequirePrivateNetworking types.Bool   `tfsdk:"require_private_networking"`
 }
 type tfGcpKmsConfigModel struct {
 	ServiceAccountKey    types.String `tfsdk:"service_account_key"`
@@ -173,6 +175,9 @@ func (r *EncryptionAtRestRS) Schema(ctx context.Context, req resource.SchemaRequ
 							Optional:  true,
 							Sensitive: true,
 						},
+						"require_private_networking": schema.BoolAttribute{
+							Optional: true,
+						},
 					},
 				},
 			},
@@ -243,6 +248,11 @@ func (r *EncryptionAtRestRS) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	if err := updateAzureKeyVaultRequirePrivateNetworking(ctx, conn, projectID, encryptionAtRestPlan.AzureKeyVaultConfig); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf(errorCreateEncryptionAtRest, projectID), err.Error())
+		return
+	}
+
 	encryptionAtRestPlanNew := newTFEncryptionAtRestRSModel(ctx, projectID, encryptionResp.(*matlas.EncryptionAtRest), encryptionAtRestPlan)
 	resetDefaultsFromConfigOrState(ctx, encryptionAtRestPlan, encryptionAtRestPlanNew, encryptionAtRestConfig)
 
@@ -303,6 +313,16 @@ func (r *EncryptionAtRestRS) Read(ctx context.Context, req resource.ReadRequest,
 		resetDefaultsFromConfigOrState(ctx, &encryptionAtRestState, encryptionAtRestStateNew, nil)
 	}
 
+	// require_private_networking is only refreshed when it's set, so configurations without it don't show changes
+	if len(encryptionAtRestStateNew.AzureKeyVaultConfig) > 0 && !encryptionAtRestStateNew.AzureKeyVaultConfig[0].RequirePrivateNetworking.IsNull() {
+		requirePrivateNetworking, err := getAzureKeyVaultRequirePrivateNetworking(ctx, conn, projectID)
+		if err != nil {
+			resp.Diagnostics.AddError("error when getting encryption at rest resource during read", fmt.Sprintf(errorReadEncryptionAtRest, err.Error()))
+			return
+		}
+		encryptionAtRestStateNew.AzureKeyVaultConfig[0].RequirePrivateNetworking = types.BoolValue(requirePrivateNetworking)
+	}
+
 	// save read data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &encryptionAtRestStateNew)...)
 	if resp.Diagnostics.HasError() {
@@ -360,6 +380,11 @@ func (r *EncryptionAtRestRS) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	if err := updateAzureKeyVaultRequirePrivateNetworking(ctx, conn, projectID, encryptionAtRestPlan.AzureKeyVaultConfig); err != nil {
+		resp.Diagnostics.AddError("error updating encryption at rest", fmt.Sprintf(errorUpdateEncryptionAtRest, err.Error()))
+		return
+	}
+
 	encryptionAtRestStateNew := newTFEncryptionAtRestRSModel(ctx, projectID, encryptionResp, encryptionAtRestPlan)
 	resetDefaultsFromConfigOrState(ctx, encryptionAtRestState, encryptionAtRestStateNew, encryptionAtRestConfig)
 
@@ -458,8 +483,10 @@ func handleAzureKeyVaultConfigDefaults(ctx context.Context, earRSCurrent, earRSN
 	// that user provided. encryptionAtRestRSConfig is nil during Read(), so we use the current plan
 	if earRSConfig != nil && len(earRSConfig.AzureKeyVaultConfig) > 0 {
 		earRSNew.AzureKeyVaultConfig[0].Secret = earRSConfig.AzureKeyVaultConfig[0].Secret
-	} else {
+		earRSNew.AzureKeyVaultConfig[0].RequirePrivateNetworking = earRSConfig.AzureKeyVaultConfig[0].RequirePrivateNetworking
+	} else if len(earRSCurrent.AzureKeyVaultConfig) > 0 {
 		earRSNew.AzureKeyVaultConfig[0].Secret = earRSCurrent.AzureKeyVaultConfig[0].Secret
+		earRSNew.AzureKeyVaultConfig[0].RequirePrivateNetworking = earRSCurrent.AzureKeyVaultConfig[0].RequirePrivateNetworking
 	}
 }
 
@@ -504,6 +531,7 @@ func newTFAzureKeyVaultConfig(ctx context.Context, az *matlas.AzureKeyVault, cur
 	newState.KeyIdentifier = types.StringValue(az.KeyIdentifier)
 	newState.TenantID = types.StringValue(az.TenantID)
 	newState.Secret = conversion.StringNullIfEmpty(az.Secret)
+	newState.RequirePrivateNetworking = types.BoolNull()
 
 	return []tfAzureKeyVaultConfigModel{newState}
 }
@@ -570,3 +598,72 @@ func newAtlasAzureKeyVault(tfAzKeyVaultConfigSlice []tfAzureKeyVaultConfigModel)
 		TenantID:          v.TenantID.ValueString(),
 	}
 }
+
+// atlasAzureKeyVaultV2 adds to the Azure Key Vault configuration the settings that are only available in the Atlas Admin API v2.
+type atlasAzureKeyVaultV2 struct {
+	*matlas.AzureKeyVault
+	RequirePrivateNetworking *bool `json:"requirePrivateNetworking,omitempty"`
+}
+
+type atlasEncryptionAtRestV2 struct {
+	AzureKeyVault *atlasAzureKeyVaultV2 `json:"azureKeyVault,omitempty"`
+}
+
+// updateAzureKeyVaultRequirePrivateNetworking sets whether Atlas must reach the Azure Key Vault over private endpoints,
+// it does nothing when require_private_networking isn't set.
+func updateAzureKeyVaultRequirePrivateNetworking(ctx context.Context, conn *matlas.Client, projectID string, azureKeyVaultConfig []tfAzureKeyVaultConfigModel) error {
+	if len(azureKeyVaultConfig) == 0 || azureKeyVaultConfig[0].RequirePrivateNetworking.IsNull() || azureKeyVaultConfig[0].RequirePrivateNetworking.IsUnknown() {
+		return nil
+	}
+
+	body := &atlasEncryptionAtRestV2{
+		AzureKeyVault: &atlasAzureKeyVaultV2{
+			AzureKeyVault:            newAtlasAzureKeyVault(azureKeyVaultConfig),
+			RequirePrivateNetworking: azureKeyVaultConfig[0].RequirePrivateNetworking.ValueBoolPointer(),
+		},
+	}
+
+	req, err := newEncryptionAtRestV2Request(ctx, conn, http.MethodPatch, fmt.Sprintf("api/atlas/v2/groups/%s/encryptionAtRest", projectID), body)
+	if err != nil {
+		return err
+	}
+
+	if _, err := conn.Do(ctx, req, nil); err != nil {
+		return fmt.Errorf("error setting require_private_networking of the Azure Key Vault: %s", err)
+	}
+
+	return nil
+}
+
+func getAzureKeyVaultRequirePrivateNetworking(ctx context.Context, conn *matlas.Client, projectID string) (bool, error) {
+	req, err := newEncryptionAtRestV2Request(ctx, conn, http.MethodGet, fmt.Sprintf("api/atlas/v2/groups/%s/encryptionAtRest", projectID), nil)
+	if err != nil {
+		return false, err
+	}
+
+	var encryptionAtRest atlasEncryptionAtRestV2
+	if _, err := conn.Do(ctx, req, &encryptionAtRest); err != nil {
+		return false, err
+	}
+	if encryptionAtRest.AzureKeyVault == nil || encryptionAtRest.AzureKeyVault.RequirePrivateNetworking == nil {
+		return false, nil
+	}
+
+	return *encryptionAtRest.AzureKeyVault.RequirePrivateNetworking, nil
+}
+
+// newEncryptionAtRestV2Request creates a request to the Atlas Admin API v2, which is needed for the encryption at rest
+// settings that the Atlas clients don't support yet.
+func newEncryptionAtRestV2Request(ctx context.Context, conn *matlas.Client, method, urlStr string, body interface{}) (*http.Request, error) {
+	req, err := conn.NewRequest(ctx, method, urlStr, body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Accept", encryptionAtRestV2MediaType)
+	if body != nil {
+		req.Header.Set("Content-Type", encryptionAtRestV2MediaType)
+	}
+
+	return req, nil
+}
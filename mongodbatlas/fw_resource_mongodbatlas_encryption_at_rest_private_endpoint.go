@@ -0,0 +1,348 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	matlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+const (
+	encryptionAtRestPrivateEndpointResourceName      = "encryption_at_rest_private_endpoint"
+	encryptionAtRestPrivateEndpointCreateTimeout     = 30 * time.Minute
+	encryptionAtRestPrivateEndpointDeleteTimeout     = 30 * time.Minute
+	encryptionAtRestPrivateEndpointStatusFailed      = "FAILED"
+	errorEncryptionAtRestPrivateEndpointCreate       = "error creating encryption at rest private endpoint in project (%s): %s"
+	errorEncryptionAtRestPrivateEndpointRead         = "error getting encryption at rest private endpoint (%s) in project (%s): %s"
+	errorEncryptionAtRestPrivateEndpointDelete       = "error deleting encryption at rest private endpoint (%s) in project (%s): %s"
+	errorEncryptionAtRestPrivateEndpointFailedDetail = "the private endpoint (%s) in region %s is in status FAILED: %s"
+)
+
+var _ resource.ResourceWithConfigure = &EncryptionAtRestPrivateEndpointRS{}
+var _ resource.ResourceWithImportState = &EncryptionAtRestPrivateEndpointRS{}
+
+func NewEncryptionAtRestPrivateEndpointRS() resource.Resource {
+	return &EncryptionAtRestPrivateEndpointRS{
+		RSCommon: RSCommon{
+			resourceName: encryptionAtRestPrivateEndpointResourceName,
+		},
+	}
+}
+
+type EncryptionAtRestPrivateEndpointRS struct {
+	RSCommon
+}
+
+type tfEncryptionAtRestPrivateEndpointModel struct {
+	ID                            types.String   `tfsdk:"id"`
+	ProjectID                     types.String   `tfsdk:"project_id"`
+	CloudProvider                 types.String   `tfsdk:"cloud_provider"`
+	RegionName                    types.String   `tfsdk:"region_name"`
+	PrivateEndpointID             types.String   `tfsdk:"private_endpoint_id"`
+	PrivateEndpointConnectionName types.String   `tfsdk:"private_endpoint_connection_name"`
+	Status                        types.String   `tfsdk:"status"`
+	ErrorMessage                  types.String   `tfsdk:"error_message"`
+	Timeouts                      timeouts.Value `tfsdk:"timeouts"`
+}
+
+// atlasEncryptionAtRestPrivateEndpoint is the private endpoint that Atlas uses to reach the key management service of
+// the cloud provider. It isn't available in the Atlas clients yet, so it's requested with the Atlas Admin API v2.
+type atlasEncryptionAtRestPrivateEndpoint struct {
+	ID                            string `json:"id,omitempty"`
+	CloudProvider                 string `json:"cloudProvider,omitempty"`
+	RegionName                    string `json:"regionName,omitempty"`
+	PrivateEndpointConnectionName string `json:"privateEndpointConnectionName,omitempty"`
+	Status                        string `json:"status,omitempty"`
+	ErrorMessage                  string `json:"errorMessage,omitempty"`
+}
+
+func (r *EncryptionAtRestPrivateEndpointRS) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	computedString := func() schema.StringAttribute {
+		return schema.StringAttribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": computedString(),
+			"project_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"cloud_provider": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("AZURE"),
+				},
+			},
+			"region_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"private_endpoint_id":              computedString(),
+			"private_endpoint_connection_name": computedString(),
+			// status and error_message change when the connection is approved or fails, so they are always refreshed
+			"status": schema.StringAttribute{
+				Computed: true,
+			},
+			"error_message": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Create requests the private endpoint and waits until Atlas has created it in the cloud provider. The endpoint is kept
+// in PENDING_ACCEPTANCE until the connection is approved in the key vault, so that status completes the creation too.
+// If the endpoint fails, it's saved with its error message and the resource is tainted so it's recreated on the next apply.
+func (r *EncryptionAtRestPrivateEndpointRS) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan tfEncryptionAtRestPrivateEndpointModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, encryptionAtRestPrivateEndpointCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.client.Atlas
+	projectID := plan.ProjectID.ValueString()
+	cloudProvider := plan.CloudProvider.ValueString()
+
+	endpoint, _, err := createEncryptionAtRestPrivateEndpoint(ctx, conn, projectID, cloudProvider, plan.RegionName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("error creating encryption at rest private endpoint", fmt.Sprintf(errorEncryptionAtRestPrivateEndpointCreate, projectID, err))
+		return
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{"INITIATING", "PENDING_RECREATION"},
+		Target:     []string{"PENDING_ACCEPTANCE", "ACTIVE", encryptionAtRestPrivateEndpointStatusFailed},
+		Refresh:    resourceEncryptionAtRestPrivateEndpointRefreshFunc(ctx, conn, projectID, cloudProvider, endpoint.ID),
+		Timeout:    createTimeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	result, err := stateConf.WaitForStateContext(ctx)
+	if endpointResult, ok := result.(*atlasEncryptionAtRestPrivateEndpoint); ok && endpointResult != nil {
+		endpoint = endpointResult
+	}
+
+	newTFEncryptionAtRestPrivateEndpointModel(projectID, endpoint, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	if err != nil {
+		resp.Diagnostics.AddError("error waiting for encryption at rest private endpoint", fmt.Sprintf(errorEncryptionAtRestPrivateEndpointCreate, projectID, err))
+		return
+	}
+	if endpoint.Status == encryptionAtRestPrivateEndpointStatusFailed {
+		resp.Diagnostics.AddError("encryption at rest private endpoint failed",
+			fmt.Sprintf(errorEncryptionAtRestPrivateEndpointFailedDetail, endpoint.ID, endpoint.RegionName, endpoint.ErrorMessage))
+	}
+}
+
+func (r *EncryptionAtRestPrivateEndpointRS) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state tfEncryptionAtRestPrivateEndpointModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ids := decodeStateID(state.ID.ValueString())
+	projectID := ids["project_id"]
+	endpointID := ids["private_endpoint_id"]
+
+	endpoint, httpResp, err := getEncryptionAtRestPrivateEndpoint(ctx, r.client.Atlas, projectID, ids["cloud_provider"], endpointID)
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("error getting encryption at rest private endpoint", fmt.Sprintf(errorEncryptionAtRestPrivateEndpointRead, endpointID, projectID, err))
+		return
+	}
+
+	newTFEncryptionAtRestPrivateEndpointModel(projectID, endpoint, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update only stores the timeouts, every other argument forces a new private endpoint.
+func (r *EncryptionAtRestPrivateEndpointRS) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state tfEncryptionAtRestPrivateEndpointModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Timeouts = plan.Timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *EncryptionAtRestPrivateEndpointRS) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state tfEncryptionAtRestPrivateEndpointModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, encryptionAtRestPrivateEndpointDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.client.Atlas
+	ids := decodeStateID(state.ID.ValueString())
+	projectID := ids["project_id"]
+	cloudProvider := ids["cloud_provider"]
+	endpointID := ids["private_endpoint_id"]
+
+	httpResp, err := deleteEncryptionAtRestPrivateEndpoint(ctx, conn, projectID, cloudProvider, endpointID)
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+			return
+		}
+		resp.Diagnostics.AddError("error deleting encryption at rest private endpoint", fmt.Sprintf(errorEncryptionAtRestPrivateEndpointDelete, endpointID, projectID, err))
+		return
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{"INITIATING", "PENDING_ACCEPTANCE", "ACTIVE", "PENDING_RECREATION", encryptionAtRestPrivateEndpointStatusFailed, "DELETING"},
+		Target:     []string{"DELETED"},
+		Refresh:    resourceEncryptionAtRestPrivateEndpointRefreshFunc(ctx, conn, projectID, cloudProvider, endpointID),
+		Timeout:    deleteTimeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		resp.Diagnostics.AddError("error deleting encryption at rest private endpoint", fmt.Sprintf(errorEncryptionAtRestPrivateEndpointDelete, endpointID, projectID, err))
+	}
+}
+
+func (r *EncryptionAtRestPrivateEndpointRS) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, "-", 3)
+	if len(parts) != 3 {
+		resp.Diagnostics.AddError("import format error",
+			"to import an encryption at rest private endpoint, use the format {project_id}-{cloud_provider}-{private_endpoint_id}")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), encodeStateID(map[string]string{
+		"project_id":          parts[0],
+		"cloud_provider":      parts[1],
+		"private_endpoint_id": parts[2],
+	}))...)
+}
+
+func resourceEncryptionAtRestPrivateEndpointRefreshFunc(ctx context.Context, conn *matlas.Client, projectID, cloudProvider, endpointID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		endpoint, resp, err := getEncryptionAtRestPrivateEndpoint(ctx, conn, projectID, cloudProvider, endpointID)
+		if err != nil {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return "", "DELETED", nil
+			}
+			return nil, "", err
+		}
+
+		return endpoint, endpoint.Status, nil
+	}
+}
+
+func newTFEncryptionAtRestPrivateEndpointModel(projectID string, endpoint *atlasEncryptionAtRestPrivateEndpoint, model *tfEncryptionAtRestPrivateEndpointModel) {
+	model.ID = types.StringValue(encodeStateID(map[string]string{
+		"project_id":          projectID,
+		"cloud_provider":      endpoint.CloudProvider,
+		"private_endpoint_id": endpoint.ID,
+	}))
+	model.ProjectID = types.StringValue(projectID)
+	model.CloudProvider = types.StringValue(endpoint.CloudProvider)
+	model.RegionName = types.StringValue(endpoint.RegionName)
+	model.PrivateEndpointID = types.StringValue(endpoint.ID)
+	model.PrivateEndpointConnectionName = types.StringValue(endpoint.PrivateEndpointConnectionName)
+	model.Status = types.StringValue(endpoint.Status)
+	model.ErrorMessage = types.StringValue(endpoint.ErrorMessage)
+}
+
+func encryptionAtRestPrivateEndpointsPath(projectID, cloudProvider string) string {
+	return fmt.Sprintf("api/atlas/v2/groups/%s/encryptionAtRest/%s/privateEndpoints", projectID, cloudProvider)
+}
+
+func createEncryptionAtRestPrivateEndpoint(ctx context.Context, conn *matlas.Client, projectID, cloudProvider, regionName string) (*atlasEncryptionAtRestPrivateEndpoint, *matlas.Response, error) {
+	req, err := newEncryptionAtRestV2Request(ctx, conn, http.MethodPost, encryptionAtRestPrivateEndpointsPath(projectID, cloudProvider),
+		&atlasEncryptionAtRestPrivateEndpoint{RegionName: regionName})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	endpoint := new(atlasEncryptionAtRestPrivateEndpoint)
+	resp, err := conn.Do(ctx, req, endpoint)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return endpoint, resp, nil
+}
+
+func getEncryptionAtRestPrivateEndpoint(ctx context.Context, conn *matlas.Client, projectID, cloudProvider, endpointID string) (*atlasEncryptionAtRestPrivateEndpoint, *matlas.Response, error) {
+	req, err := newEncryptionAtRestV2Request(ctx, conn, http.MethodGet, fmt.Sprintf("%s/%s", encryptionAtRestPrivateEndpointsPath(projectID, cloudProvider), endpointID), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	endpoint := new(atlasEncryptionAtRestPrivateEndpoint)
+	resp, err := conn.Do(ctx, req, endpoint)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return endpoint, resp, nil
+}
+
+func deleteEncryptionAtRestPrivateEndpoint(ctx context.Context, conn *matlas.Client, projectID, cloudProvider, endpointID string) (*matlas.Response, error) {
+	req, err := newEncryptionAtRestV2Request(ctx, conn, http.MethodDelete, fmt.Sprintf("%s/%s", encryptionAtRestPrivateEndpointsPath(projectID, cloudProvider), endpointID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return conn.Do(ctx, req, nil)
+}
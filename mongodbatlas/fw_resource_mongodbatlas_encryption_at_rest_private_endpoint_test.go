@@ -0,0 +1,172 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/mwielbut/pointy"
+	matlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+func TestAccAdvRSEncryptionAtRestPrivateEndpoint_basicAzure(t *testing.T) {
+	SkipTestExtCred(t)
+	var (
+		resourceName = "mongodbatlas_encryption_at_rest_private_endpoint.test"
+		projectID    = os.Getenv("MONGODB_ATLAS_PROJECT_ID")
+		regionName   = os.Getenv("AZURE_REGION")
+
+		azureKeyVault = matlas.AzureKeyVault{
+			Enabled:           pointy.Bool(true),
+			ClientID:          os.Getenv("AZURE_CLIENT_ID"),
+			AzureEnvironment:  "AZURE",
+			SubscriptionID:    os.Getenv("AZURE_SUBSCRIPTION_ID"),
+			ResourceGroupName: os.Getenv("AZURE_RESOURCE_GROUP_NAME"),
+			KeyVaultName:      os.Getenv("AZURE_KEY_VAULT_NAME"),
+			KeyIdentifier:     os.Getenv("AZURE_KEY_IDENTIFIER"),
+			Secret:            os.Getenv("AZURE_SECRET"),
+			TenantID:          os.Getenv("AZURE_TENANT_ID"),
+		}
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testCheckEncryptionAtRestEnvAzure(t)
+			if regionName == "" {
+				t.Fatal("`AZURE_REGION` must be set for Encryption At Rest private endpoint acceptance testing")
+			}
+		},
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasEncryptionAtRestPrivateEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasEncryptionAtRestPrivateEndpointConfig(projectID, regionName, &azureKeyVault),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasEncryptionAtRestPrivateEndpointExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "project_id", projectID),
+					resource.TestCheckResourceAttr(resourceName, "cloud_provider", "AZURE"),
+					resource.TestCheckResourceAttr(resourceName, "region_name", regionName),
+					resource.TestCheckResourceAttrSet(resourceName, "private_endpoint_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "private_endpoint_connection_name"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+					resource.TestCheckResourceAttr("mongodbatlas_encryption_at_rest.test", "azure_key_vault_config.0.require_private_networking", "false"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportStateIdFunc:       testAccCheckMongoDBAtlasEncryptionAtRestPrivateEndpointImportStateIDFunc(resourceName),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"status", "error_message"},
+			},
+		},
+	})
+}
+
+func TestNewTFEncryptionAtRestPrivateEndpointModel(t *testing.T) {
+	model := tfEncryptionAtRestPrivateEndpointModel{
+		ID: types.StringUnknown(),
+	}
+	endpoint := &atlasEncryptionAtRestPrivateEndpoint{
+		ID:                            "65e1e0a3c4d4bb3f0f0a5f0c",
+		CloudProvider:                 "AZURE",
+		RegionName:                    "US_EAST_2",
+		PrivateEndpointConnectionName: "mongodb-atlas-kms-connection",
+		Status:                        encryptionAtRestPrivateEndpointStatusFailed,
+		ErrorMessage:                  "the private endpoint was rejected",
+	}
+
+	newTFEncryptionAtRestPrivateEndpointModel("5cf5a45a9ccf6400e60981b6", endpoint, &model)
+
+	ids := decodeStateID(model.ID.ValueString())
+	if ids["project_id"] != "5cf5a45a9ccf6400e60981b6" || ids["cloud_provider"] != "AZURE" || ids["private_endpoint_id"] != endpoint.ID {
+		t.Errorf("unexpected state ID: %v", ids)
+	}
+	if model.Status.ValueString() != encryptionAtRestPrivateEndpointStatusFailed {
+		t.Errorf("expected status %s, got %s", encryptionAtRestPrivateEndpointStatusFailed, model.Status.ValueString())
+	}
+	if model.ErrorMessage.ValueString() != endpoint.ErrorMessage {
+		t.Errorf("expected error message %q, got %q", endpoint.ErrorMessage, model.ErrorMessage.ValueString())
+	}
+}
+
+func testAccCheckMongoDBAtlasEncryptionAtRestPrivateEndpointExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testMongoDBClient.(*MongoDBClient).Atlas
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		ids := decodeStateID(rs.Primary.ID)
+		if _, _, err := getEncryptionAtRestPrivateEndpoint(context.Background(), conn, ids["project_id"], ids["cloud_provider"], ids["private_endpoint_id"]); err != nil {
+			return fmt.Errorf("encryption at rest private endpoint (%s) does not exist", ids["private_endpoint_id"])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckMongoDBAtlasEncryptionAtRestPrivateEndpointDestroy(s *terraform.State) error {
+	conn := testMongoDBClient.(*MongoDBClient).Atlas
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "mongodbatlas_encryption_at_rest_private_endpoint" {
+			continue
+		}
+
+		ids := decodeStateID(rs.Primary.ID)
+		if _, _, err := getEncryptionAtRestPrivateEndpoint(context.Background(), conn, ids["project_id"], ids["cloud_provider"], ids["private_endpoint_id"]); err == nil {
+			return fmt.Errorf("encryption at rest private endpoint (%s) still exists", ids["private_endpoint_id"])
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckMongoDBAtlasEncryptionAtRestPrivateEndpointImportStateIDFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+
+		ids := decodeStateID(rs.Primary.ID)
+
+		return fmt.Sprintf("%s-%s-%s", ids["project_id"], ids["cloud_provider"], ids["private_endpoint_id"]), nil
+	}
+}
+
+func testAccMongoDBAtlasEncryptionAtRestPrivateEndpointConfig(projectID, regionName string, azure *matlas.AzureKeyVault) string {
+	return fmt.Sprintf(`
+		resource "mongodbatlas_encryption_at_rest" "test" {
+			project_id = "%[1]s"
+
+			azure_key_vault_config {
+				enabled                    = %[3]t
+				client_id                  = "%[4]s"
+				azure_environment          = "%[5]s"
+				subscription_id            = "%[6]s"
+				resource_group_name        = "%[7]s"
+				key_vault_name             = "%[8]s"
+				key_identifier             = "%[9]s"
+				secret                     = "%[10]s"
+				tenant_id                  = "%[11]s"
+				require_private_networking = false
+			}
+		}
+
+		resource "mongodbatlas_encryption_at_rest_private_endpoint" "test" {
+			project_id     = mongodbatlas_encryption_at_rest.test.project_id
+			cloud_provider = "AZURE"
+			region_name    = "%[2]s"
+		}
+	`, projectID, regionName, *azure.Enabled, azure.ClientID, azure.AzureEnvironment, azure.SubscriptionID, azure.ResourceGroupName,
+		azure.KeyVaultName, azure.KeyIdentifier, azure.Secret, azure.TenantID)
+}
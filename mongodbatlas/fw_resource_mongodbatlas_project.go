@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -40,10 +41,12 @@ const (
 	projectDependentsStateIdle     = "IDLE"
 	projectDependentsStateDeleting = "DELETING"
 	projectDependentsStateRetry    = "RETRY"
+	projectSettingsV2MediaType     = "application/vnd.atlas.2023-01-01+json"
 )
 
 var _ resource.ResourceWithConfigure = &ProjectRS{}
 var _ resource.ResourceWithImportState = &ProjectRS{}
+var _ resource.ResourceWithModifyPlan = &ProjectRS{}
 
 func NewProjectRS() resource.Resource {
 	return &ProjectRS{
@@ -74,6 +77,27 @@ type tfProjectRSModel struct {
 	IsExtendedStorageSizesEnabled               types.Bool   `tfsdk:"is_extended_storage_sizes_enabled"`
 	IsCollectDatabaseSpecificsStatisticsEnabled types.Bool   `tfsdk:"is_collect_database_specifics_statistics_enabled"`
 	WithDefaultAlertsSettings                   types.Bool   `tfsdk:"with_default_alerts_settings"`
+	IgnoreUnmanagedTeams                        types.Bool   `tfsdk:"ignore_unmanaged_teams"`
+	AuthoritativeSettings                       types.Bool   `tfsdk:"authoritative_settings"`
+}
+
+// projectSetting describes a project setting and the value enforced when authoritative_settings is enabled
+// and the setting is not defined in the configuration.
+type projectSetting struct {
+	attrName      string
+	apiName       string
+	secureDefault bool
+}
+
+// projectSettingsCatalog lists every project setting managed by the resource. New settings must be added here
+// so they are enforced by authoritative_settings without configuration changes.
+var projectSettingsCatalog = []projectSetting{
+	{attrName: "is_collect_database_specifics_statistics_enabled", apiName: "isCollectDatabaseSpecificsStatisticsEnabled", secureDefault: true},
+	{attrName: "is_data_explorer_enabled", apiName: "isDataExplorerEnabled", secureDefault: false},
+	{attrName: "is_extended_storage_sizes_enabled", apiName: "isExtendedStorageSizesEnabled", secureDefault: false},
+	{attrName: "is_performance_advisor_enabled", apiName: "isPerformanceAdvisorEnabled", secureDefault: true},
+	{attrName: "is_realtime_performance_panel_enabled", apiName: "isRealtimePerformancePanelEnabled", secureDefault: false},
+	{attrName: "is_schema_advisor_enabled", apiName: "isSchemaAdvisorEnabled", secureDefault: true},
 }
 
 type tfTeamModel struct {
@@ -191,6 +215,12 @@ func (r *ProjectRS) Schema(ctx context.Context, req resource.SchemaRequest, resp
 			"region_usage_restrictions": schema.StringAttribute{
 				Optional: true,
 			},
+			"ignore_unmanaged_teams": schema.BoolAttribute{
+				Optional: true,
+			},
+			"authoritative_settings": schema.BoolAttribute{
+				Optional: true,
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"teams": schema.SetNestedBlock{
@@ -225,6 +255,9 @@ func (r *ProjectRS) Schema(ctx context.Context, req resource.SchemaRequest, resp
 							Computed: true,
 						},
 					},
+					Validators: []validator.Object{
+						projectLimitValidator{},
+					},
 				},
 				// https://discuss.hashicorp.com/t/computed-attributes-and-plan-modifiers/45830/12
 				PlanModifiers: []planmodifier.Set{
@@ -235,6 +268,55 @@ func (r *ProjectRS) Schema(ctx context.Context, req resource.SchemaRequest, resp
 	}
 }
 
+// ModifyPlan enforces the secure default of every project setting that is not defined in the configuration
+// when authoritative_settings is enabled, so changes made outside of Terraform are reported as drift. Enabled
+// settings that this version of the provider doesn't know can't be enforced, so they fail the plan.
+func (r *ProjectRS) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var authoritativeSettings types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("authoritative_settings"), &authoritativeSettings)...)
+	if resp.Diagnostics.HasError() || !authoritativeSettings.ValueBool() {
+		return
+	}
+
+	for _, setting := range projectSettingsCatalog {
+		var configValue types.Bool
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(setting.attrName), &configValue)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if configValue.IsNull() {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(setting.attrName), types.BoolValue(setting.secureDefault))...)
+		}
+	}
+
+	// new projects are created with the Atlas defaults, so only existing ones are checked
+	if req.State.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var projectID types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &projectID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	unknownSettings, err := getEnabledUnknownProjectSettings(ctx, r.client.Atlas, projectID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("error when getting project settings", fmt.Sprintf(errorProjectRead, projectID.ValueString(), err.Error()))
+		return
+	}
+	for _, name := range unknownSettings {
+		resp.Diagnostics.AddAttributeError(path.Root("authoritative_settings"), "project setting not managed by the provider",
+			fmt.Sprintf("project (%s) has the setting %q enabled, but this version of the provider can't enforce its secure default with authoritative_settings. "+
+				"Upgrade the provider, disable the setting in Atlas, or set authoritative_settings to false.", projectID.ValueString(), name))
+	}
+}
+
 func (r *ProjectRS) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var projectPlan tfProjectRSModel
 	var teams []tfTeamModel
@@ -369,6 +451,9 @@ func (r *ProjectRS) Create(ctx context.Context, req resource.CreateRequest, resp
 	}
 
 	atlasLimits = filterUserDefinedLimits(atlasLimits, limits)
+	if projectPlan.IgnoreUnmanagedTeams.ValueBool() {
+		atlasTeams = filterUserDefinedTeams(atlasTeams, teams)
+	}
 	projectPlanNew := newTFProjectResourceModel(ctx, projectRes, atlasTeams, atlasProjectSettings, atlasLimits)
 	updatePlanFromConfig(projectPlanNew, &projectPlan)
 
@@ -383,6 +468,7 @@ func (r *ProjectRS) Create(ctx context.Context, req resource.CreateRequest, resp
 func (r *ProjectRS) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var projectState tfProjectRSModel
 	var limits []tfLimitModel
+	var teams []tfTeamModel
 	conn := r.client.Atlas
 	connV2 := r.client.AtlasV2
 
@@ -396,6 +482,9 @@ func (r *ProjectRS) Read(ctx context.Context, req resource.ReadRequest, resp *re
 	if len(projectState.Limits.Elements()) > 0 {
 		_ = projectState.Limits.ElementsAs(ctx, &limits, false)
 	}
+	if len(projectState.Teams.Elements()) > 0 {
+		_ = projectState.Teams.ElementsAs(ctx, &teams, false)
+	}
 
 	// get project
 	projectRes, atlasResp, err := conn.Projects.GetOneProject(ctx, projectID)
@@ -416,6 +505,10 @@ func (r *ProjectRS) Read(ctx context.Context, req resource.ReadRequest, resp *re
 	}
 
 	atlasLimits = filterUserDefinedLimits(atlasLimits, limits)
+	if projectState.IgnoreUnmanagedTeams.ValueBool() {
+		atlasTeams = filterUserDefinedTeams(atlasTeams, teams)
+	}
+
 	projectStateNew := newTFProjectResourceModel(ctx, projectRes, atlasTeams, atlasProjectSettings, atlasLimits)
 	updatePlanFromConfig(projectStateNew, &projectState)
 
@@ -487,6 +580,11 @@ func (r *ProjectRS) Update(ctx context.Context, req resource.UpdateRequest, resp
 	var planLimits []tfLimitModel
 	_ = projectPlan.Limits.ElementsAs(ctx, &planLimits, false)
 	atlasLimits = filterUserDefinedLimits(atlasLimits, planLimits)
+	if projectPlan.IgnoreUnmanagedTeams.ValueBool() {
+		var planTeams []tfTeamModel
+		_ = projectPlan.Teams.ElementsAs(ctx, &planTeams, false)
+		atlasTeams = filterUserDefinedTeams(atlasTeams, planTeams)
+	}
 	projectPlanNew := newTFProjectResourceModel(ctx, projectRes, atlasTeams, atlasProjectSettings, atlasLimits)
 	updatePlanFromConfig(projectPlanNew, &projectPlan)
 
@@ -521,6 +619,39 @@ func updatePlanFromConfig(projectPlanNewPtr, projectPlan *tfProjectRSModel) {
 	// https://discuss.hashicorp.com/t/boolean-optional-default-value-migration-to-framework/55932
 	projectPlanNewPtr.WithDefaultAlertsSettings = projectPlan.WithDefaultAlertsSettings
 	projectPlanNewPtr.ProjectOwnerID = projectPlan.ProjectOwnerID
+	projectPlanNewPtr.IgnoreUnmanagedTeams = projectPlan.IgnoreUnmanagedTeams
+	projectPlanNewPtr.AuthoritativeSettings = projectPlan.AuthoritativeSettings
+}
+
+// getEnabledUnknownProjectSettings returns the enabled project settings that Atlas reports but are not in projectSettingsCatalog,
+// e.g. settings added to Atlas after this version of the provider was released. The settings are read as raw JSON
+// because the SDK models drop the fields they don't know.
+func getEnabledUnknownProjectSettings(ctx context.Context, conn *matlas.Client, projectID string) ([]string, error) {
+	req, err := conn.NewRequest(ctx, http.MethodGet, fmt.Sprintf("api/atlas/v2/groups/%s/settings", projectID), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", projectSettingsV2MediaType)
+
+	var rawSettings map[string]interface{}
+	if _, err := conn.Do(ctx, req, &rawSettings); err != nil {
+		return nil, err
+	}
+
+	knownSettings := make(map[string]bool, len(projectSettingsCatalog))
+	for _, setting := range projectSettingsCatalog {
+		knownSettings[setting.apiName] = true
+	}
+
+	var unknownSettings []string
+	for name, value := range rawSettings {
+		if enabled, ok := value.(bool); ok && enabled && !knownSettings[name] {
+			unknownSettings = append(unknownSettings, name)
+		}
+	}
+	sort.Strings(unknownSettings)
+
+	return unknownSettings, nil
 }
 
 func filterUserDefinedLimits(allAtlasLimits []admin.DataFederationLimit, tflimits []tfLimitModel) []admin.DataFederationLimit {
@@ -540,6 +671,25 @@ func filterUserDefinedLimits(allAtlasLimits []admin.DataFederationLimit, tflimit
 	return filteredLimits
 }
 
+// filterUserDefinedTeams keeps only the teams defined in the resource, so teams assigned to the project
+// by other means (e.g. mongodbatlas_team_project_assignment) are not reported as changes.
+func filterUserDefinedTeams(atlasTeams *matlas.TeamsAssigned, tfTeams []tfTeamModel) *matlas.TeamsAssigned {
+	definedTeams := make(map[string]bool, len(tfTeams))
+	for _, team := range tfTeams {
+		definedTeams[team.TeamID.ValueString()] = true
+	}
+
+	filteredTeams := &matlas.TeamsAssigned{Links: atlasTeams.Links}
+	for _, team := range atlasTeams.Results {
+		if definedTeams[team.TeamID] {
+			filteredTeams.Results = append(filteredTeams.Results, team)
+		}
+	}
+	filteredTeams.TotalCount = len(filteredTeams.Results)
+
+	return filteredTeams
+}
+
 func getProjectPropsFromAPI(ctx context.Context, conn *matlas.Client, connV2 *admin.APIClient, projectID string) (*matlas.TeamsAssigned, []admin.DataFederationLimit, *matlas.ProjectSettings, error) {
 	teams, _, err := conn.Projects.GetProjectTeamsAssigned(ctx, projectID)
 	if err != nil {
@@ -687,7 +837,7 @@ func updateProjectLimits(ctx context.Context, connV2 *admin.APIClient, projectSt
 	// removing limits from the project
 	for _, limit := range removedLimits {
 		limitName := limit.Name.ValueString()
-		if _, _, err := connV2.ProjectsApi.DeleteProjectLimit(ctx, limitName, projectID).Execute(); err != nil {
+		if err := resetProjectLimit(ctx, connV2, projectID, limitName); err != nil {
 			return fmt.Errorf("error removing limit %s from the project(%s) during update: %s", limitName, projectID, err)
 		}
 	}
@@ -0,0 +1,353 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.mongodb.org/atlas-sdk/v20230201006/admin"
+)
+
+const (
+	projectLimitResourceName = "project_limit"
+	errorProjectLimitSet     = "error setting limit (%s) in project (%s): %s"
+	errorProjectLimitRead    = "error getting limit (%s) in project (%s): %s"
+	errorProjectLimitReset   = "error resetting limit (%s) in project (%s) to its default: %s"
+)
+
+// projectLimitBounds describes a limit that can be configured in a project. defaultLimit and maximumLimit are nil
+// when Atlas doesn't define them, for example for the Data Federation limits which are expressed in bytes.
+type projectLimitBounds struct {
+	defaultLimit *int64
+	maximumLimit *int64
+	minimumLimit int64
+}
+
+// projectLimitsCatalog contains the limits that can be configured in a project, as documented in
+// https://www.mongodb.com/docs/atlas/reference/api-resources-spec/#tag/Projects/operation/setProjectLimit
+var projectLimitsCatalog = map[string]projectLimitBounds{
+	"atlas.project.deployment.clusters":                                {defaultLimit: pointer[int64](25), maximumLimit: pointer[int64](90), minimumLimit: 1},
+	"atlas.project.deployment.nodesPerPrivateLinkRegion":               {defaultLimit: pointer[int64](50), maximumLimit: pointer[int64](90), minimumLimit: 1},
+	"atlas.project.security.databaseAccess.customRoles":                {defaultLimit: pointer[int64](100), maximumLimit: pointer[int64](1400), minimumLimit: 1},
+	"atlas.project.security.databaseAccess.users":                      {defaultLimit: pointer[int64](100), maximumLimit: pointer[int64](900), minimumLimit: 1},
+	"atlas.project.security.networkAccess.crossRegionEntries":          {defaultLimit: pointer[int64](40), maximumLimit: pointer[int64](220), minimumLimit: 1},
+	"atlas.project.security.networkAccess.entries":                     {defaultLimit: pointer[int64](200), minimumLimit: 1},
+	"atlas.project.deployment.privateServiceConnectionsPerRegionGroup": {defaultLimit: pointer[int64](50), maximumLimit: pointer[int64](100), minimumLimit: 1},
+	"atlas.project.deployment.privateServiceConnectionsSubnetMask":     {defaultLimit: pointer[int64](27), maximumLimit: pointer[int64](27), minimumLimit: 20},
+	"atlas.project.deployment.serverlessMTMs":                          {defaultLimit: pointer[int64](25), maximumLimit: pointer[int64](100), minimumLimit: 1},
+	"dataFederation.bytesProcessed.query":                              {},
+	"dataFederation.bytesProcessed.daily":                              {},
+	"dataFederation.bytesProcessed.weekly":                             {},
+	"dataFederation.bytesProcessed.monthly":                            {},
+}
+
+func projectLimitNames() []string {
+	names := make([]string, 0, len(projectLimitsCatalog))
+	for name := range projectLimitsCatalog {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func isKnownProjectLimit(name string) bool {
+	_, ok := projectLimitsCatalog[name]
+	return ok
+}
+
+// validateProjectLimit checks the name and the value of a limit against the catalog of known project limits.
+func validateProjectLimit(name string, value int64) error {
+	bounds, ok := projectLimitsCatalog[name]
+	if !ok {
+		return fmt.Errorf("unknown limit name %q, valid names are: %s", name, strings.Join(projectLimitNames(), ", "))
+	}
+	if value < bounds.minimumLimit {
+		return fmt.Errorf("value %d of limit %q must be at least %d", value, name, bounds.minimumLimit)
+	}
+	if bounds.maximumLimit != nil && value > *bounds.maximumLimit {
+		return fmt.Errorf("value %d of limit %q exceeds the Atlas maximum of %d", value, name, *bounds.maximumLimit)
+	}
+	return nil
+}
+
+// resetProjectLimit removes the limit override of the project. Atlas doesn't restore the default value of every limit
+// when the override is removed, so the default from the catalog is set explicitly when the value differs from it.
+func resetProjectLimit(ctx context.Context, connV2 *admin.APIClient, projectID, name string) error {
+	if _, httpResp, err := connV2.ProjectsApi.DeleteProjectLimit(ctx, name, projectID).Execute(); err != nil {
+		if httpResp == nil || httpResp.StatusCode != http.StatusNotFound {
+			return err
+		}
+	}
+
+	bounds, ok := projectLimitsCatalog[name]
+	if !ok || bounds.defaultLimit == nil {
+		return nil
+	}
+
+	limit, _, err := connV2.ProjectsApi.GetProjectLimit(ctx, name, projectID).Execute()
+	if err != nil {
+		return err
+	}
+	if limit.Value == *bounds.defaultLimit {
+		return nil
+	}
+
+	_, _, err = connV2.ProjectsApi.SetProjectLimit(ctx, name, projectID, &admin.DataFederationLimit{
+		Name:  name,
+		Value: *bounds.defaultLimit,
+	}).Execute()
+	return err
+}
+
+type projectLimitValidator struct{}
+
+func (v projectLimitValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("name must be one of: %s, and value must be within the bounds Atlas allows for the limit", strings.Join(projectLimitNames(), ", "))
+}
+
+func (v projectLimitValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v projectLimitValidator) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	attrs := req.ConfigValue.Attributes()
+	name, nameOk := attrs["name"].(types.String)
+	value, valueOk := attrs["value"].(types.Int64)
+	if !nameOk || !valueOk || name.IsUnknown() || name.IsNull() || value.IsUnknown() || value.IsNull() {
+		return
+	}
+
+	if err := validateProjectLimit(name.ValueString(), value.ValueInt64()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "invalid project limit", err.Error())
+	}
+}
+
+var _ resource.ResourceWithConfigure = &ProjectLimitRS{}
+var _ resource.ResourceWithImportState = &ProjectLimitRS{}
+var _ resource.ResourceWithValidateConfig = &ProjectLimitRS{}
+
+func NewProjectLimitRS() resource.Resource {
+	return &ProjectLimitRS{
+		RSCommon: RSCommon{
+			resourceName: projectLimitResourceName,
+		},
+	}
+}
+
+type ProjectLimitRS struct {
+	RSCommon
+}
+
+type tfProjectLimitRSModel struct {
+	ID                 types.String `tfsdk:"id"`
+	ProjectID          types.String `tfsdk:"project_id"`
+	Name               types.String `tfsdk:"name"`
+	Value              types.Int64  `tfsdk:"value"`
+	CurrentUsage       types.Int64  `tfsdk:"current_usage"`
+	DefaultLimit       types.Int64  `tfsdk:"default_limit"`
+	MaximumLimit       types.Int64  `tfsdk:"maximum_limit"`
+	SkipNameValidation types.Bool   `tfsdk:"skip_name_validation"`
+}
+
+func (r *ProjectLimitRS) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.Int64Attribute{
+				Required: true,
+			},
+			"skip_name_validation": schema.BoolAttribute{
+				Optional: true,
+			},
+			"current_usage": schema.Int64Attribute{
+				Computed: true,
+			},
+			"default_limit": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"maximum_limit": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ProjectLimitRS) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config tfProjectLimitRSModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Name.IsUnknown() || config.Name.IsNull() || config.Value.IsUnknown() || config.Value.IsNull() {
+		return
+	}
+
+	// limits added to Atlas after the provider release can be set by skipping the validation of the name
+	if config.SkipNameValidation.ValueBool() && !isKnownProjectLimit(config.Name.ValueString()) {
+		return
+	}
+
+	if err := validateProjectLimit(config.Name.ValueString(), config.Value.ValueInt64()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("value"), "invalid project limit", err.Error())
+	}
+}
+
+func (r *ProjectLimitRS) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan tfProjectLimitRSModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	limit, err := setProjectLimit(ctx, r.client.AtlasV2, plan.ProjectID.ValueString(), plan.Name.ValueString(), plan.Value.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("error creating project limit", fmt.Sprintf(errorProjectLimitSet, plan.Name.ValueString(), plan.ProjectID.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, newTFProjectLimitRSModel(plan.ProjectID.ValueString(), limit, plan.SkipNameValidation))...)
+}
+
+func (r *ProjectLimitRS) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state tfProjectLimitRSModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ids := decodeStateID(state.ID.ValueString())
+	projectID := ids["project_id"]
+	name := ids["name"]
+
+	limit, httpResp, err := r.client.AtlasV2.ProjectsApi.GetProjectLimit(ctx, name, projectID).Execute()
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("error getting project limit", fmt.Sprintf(errorProjectLimitRead, name, projectID, err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, newTFProjectLimitRSModel(projectID, limit, state.SkipNameValidation))...)
+}
+
+func (r *ProjectLimitRS) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan tfProjectLimitRSModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	limit, err := setProjectLimit(ctx, r.client.AtlasV2, plan.ProjectID.ValueString(), plan.Name.ValueString(), plan.Value.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("error updating project limit", fmt.Sprintf(errorProjectLimitSet, plan.Name.ValueString(), plan.ProjectID.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, newTFProjectLimitRSModel(plan.ProjectID.ValueString(), limit, plan.SkipNameValidation))...)
+}
+
+// Delete resets the limit to its default value, a project can't exist without its limits.
+func (r *ProjectLimitRS) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state tfProjectLimitRSModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := state.ProjectID.ValueString()
+	name := state.Name.ValueString()
+
+	if err := resetProjectLimit(ctx, r.client.AtlasV2, projectID, name); err != nil {
+		resp.Diagnostics.AddError("error deleting project limit", fmt.Sprintf(errorProjectLimitReset, name, projectID, err))
+	}
+}
+
+func (r *ProjectLimitRS) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, "-", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError("import format error", "to import a project limit, use the format {project_id}-{name}")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), encodeStateID(map[string]string{
+		"project_id": parts[0],
+		"name":       parts[1],
+	}))...)
+}
+
+func setProjectLimit(ctx context.Context, connV2 *admin.APIClient, projectID, name string, value int64) (*admin.DataFederationLimit, error) {
+	if _, _, err := connV2.ProjectsApi.SetProjectLimit(ctx, name, projectID, &admin.DataFederationLimit{
+		Name:  name,
+		Value: value,
+	}).Execute(); err != nil {
+		return nil, err
+	}
+
+	limit, _, err := connV2.ProjectsApi.GetProjectLimit(ctx, name, projectID).Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	return limit, nil
+}
+
+func newTFProjectLimitRSModel(projectID string, limit *admin.DataFederationLimit, skipNameValidation types.Bool) *tfProjectLimitRSModel {
+	return &tfProjectLimitRSModel{
+		ID: types.StringValue(encodeStateID(map[string]string{
+			"project_id": projectID,
+			"name":       limit.Name,
+		})),
+		ProjectID:          types.StringValue(projectID),
+		Name:               types.StringValue(limit.Name),
+		Value:              types.Int64Value(limit.Value),
+		CurrentUsage:       types.Int64PointerValue(limit.CurrentUsage),
+		DefaultLimit:       types.Int64PointerValue(limit.DefaultLimit),
+		MaximumLimit:       types.Int64PointerValue(limit.MaximumLimit),
+		SkipNameValidation: skipNameValidation,
+	}
+}
@@ -0,0 +1,148 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccProjectRSProjectLimit_basic(t *testing.T) {
+	var (
+		resourceName = "mongodbatlas_project_limit.test"
+		projectName  = acctest.RandomWithPrefix("tf-acc-project")
+		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		limitName    = "atlas.project.deployment.clusters"
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasProjectLimitConfig(projectName, orgID, limitName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasProjectLimitValue(resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "name", limitName),
+					resource.TestCheckResourceAttr(resourceName, "value", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "default_limit"),
+					resource.TestCheckResourceAttrSet(resourceName, "maximum_limit"),
+				),
+			},
+			{
+				Config: testAccMongoDBAtlasProjectLimitConfig(projectName, orgID, limitName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasProjectLimitValue(resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "value", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateIdFunc: testAccCheckMongoDBAtlasProjectLimitImportStateIDFunc(resourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccProjectRSProjectLimit_invalidValue(t *testing.T) {
+	var (
+		projectName = acctest.RandomWithPrefix("tf-acc-project")
+		orgID       = os.Getenv("MONGODB_ATLAS_ORG_ID")
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccMongoDBAtlasProjectLimitConfig(projectName, orgID, "atlas.project.security.databaseAccess.users", 5000),
+				ExpectError: regexp.MustCompile("exceeds the Atlas maximum"),
+			},
+		},
+	})
+}
+
+func TestValidateProjectLimit(t *testing.T) {
+	testCases := []struct {
+		name        string
+		limitName   string
+		value       int64
+		expectError bool
+	}{
+		{name: "valid limit", limitName: "atlas.project.deployment.clusters", value: 30},
+		{name: "maximum value", limitName: "atlas.project.deployment.clusters", value: 90},
+		{name: "over maximum", limitName: "atlas.project.deployment.clusters", value: 91, expectError: true},
+		{name: "under minimum", limitName: "atlas.project.deployment.privateServiceConnectionsSubnetMask", value: 19, expectError: true},
+		{name: "limit without maximum", limitName: "dataFederation.bytesProcessed.daily", value: 1099511627776},
+		{name: "serverless instances", limitName: "atlas.project.deployment.serverlessMTMs", value: 100},
+		{name: "unknown name", limitName: "incorrect.name", value: 1, expectError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateProjectLimit(tc.limitName, tc.value)
+			if tc.expectError != (err != nil) {
+				t.Errorf("validateProjectLimit(%q, %d) returned error %v, expected error: %t", tc.limitName, tc.value, err, tc.expectError)
+			}
+		})
+	}
+}
+
+func testAccCheckMongoDBAtlasProjectLimitValue(resourceName string, value int64) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		connV2 := testMongoDBClient.(*MongoDBClient).AtlasV2
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		ids := decodeStateID(rs.Primary.ID)
+		limit, _, err := connV2.ProjectsApi.GetProjectLimit(context.Background(), ids["name"], ids["project_id"]).Execute()
+		if err != nil {
+			return fmt.Errorf("limit (%s) does not exist in project (%s): %s", ids["name"], ids["project_id"], err)
+		}
+		if limit.Value != value {
+			return fmt.Errorf("limit (%s) has value %d, expected %d", ids["name"], limit.Value, value)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckMongoDBAtlasProjectLimitImportStateIDFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s-%s", rs.Primary.Attributes["project_id"], rs.Primary.Attributes["name"]), nil
+	}
+}
+
+func testAccMongoDBAtlasProjectLimitConfig(projectName, orgID, limitName string, value int64) string {
+	return fmt.Sprintf(`
+		resource "mongodbatlas_project" "test" {
+			name   = %[1]q
+			org_id = %[2]q
+		}
+
+		resource "mongodbatlas_project_limit" "test" {
+			project_id = mongodbatlas_project.test.id
+			name       = %[3]q
+			value      = %[4]d
+		}
+	`, projectName, orgID, limitName, value)
+}
@@ -227,6 +227,47 @@ func TestAccProjectRSProject_CreateWithFalseDefaultAdvSettings(t *testing.T) {
 	})
 }
 
+func TestAccProjectRSProject_withAuthoritativeSettings(t *testing.T) {
+	var (
+		project      matlas.Project
+		resourceName = "mongodbatlas_project.test"
+		projectName  = acctest.RandomWithPrefix("tf-acc-project")
+		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasProjectConfigWithAuthoritativeSettings(projectName, orgID, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasProjectExists(resourceName, &project),
+					resource.TestCheckResourceAttr(resourceName, "authoritative_settings", "true"),
+					resource.TestCheckResourceAttr(resourceName, "is_data_explorer_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "is_realtime_performance_panel_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "is_performance_advisor_enabled", "true"),
+				),
+			},
+			{
+				Config: testAccMongoDBAtlasProjectConfigWithAuthoritativeSettings(projectName, orgID, "is_data_explorer_enabled = true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasProjectExists(resourceName, &project),
+					resource.TestCheckResourceAttr(resourceName, "is_data_explorer_enabled", "true"),
+				),
+			},
+			{
+				Config: testAccMongoDBAtlasProjectConfigWithAuthoritativeSettings(projectName, orgID, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasProjectExists(resourceName, &project),
+					resource.TestCheckResourceAttr(resourceName, "is_data_explorer_enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccProjectRSProject_withUpdatedRole(t *testing.T) {
 	var (
 		resourceName    = "mongodbatlas_project.test"
@@ -403,7 +444,7 @@ func TestAccProjectRSProject_withInvalidLimitName(t *testing.T) {
 						Value: 1,
 					},
 				}),
-				ExpectError: regexp.MustCompile("Not Found"),
+				ExpectError: regexp.MustCompile("unknown limit name"),
 			},
 		},
 	})
@@ -435,7 +476,31 @@ func TestAccProjectRSProject_withInvalidLimitNameOnUpdate(t *testing.T) {
 						Value: 1,
 					},
 				}),
-				ExpectError: regexp.MustCompile("Not Found"),
+				ExpectError: regexp.MustCompile("unknown limit name"),
+			},
+		},
+	})
+}
+
+func TestAccProjectRSProject_withLimitOverMaximum(t *testing.T) {
+	var (
+		projectName = acctest.RandomWithPrefix("tf-acc-project")
+		orgID       = os.Getenv("MONGODB_ATLAS_ORG_ID")
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasProjectConfigWithLimits(projectName, orgID, []*admin.DataFederationLimit{
+					{
+						Name:  "atlas.project.deployment.clusters",
+						Value: 1000,
+					},
+				}),
+				ExpectError: regexp.MustCompile("exceeds the Atlas maximum"),
 			},
 		},
 	})
@@ -588,6 +653,17 @@ func testAccMongoDBAtlasProjectConfigWithFalseDefaultAdvSettings(projectName, or
 	`, projectName, orgID, projectOwnerID)
 }
 
+func testAccMongoDBAtlasProjectConfigWithAuthoritativeSettings(projectName, orgID, settings string) string {
+	return fmt.Sprintf(`
+		resource "mongodbatlas_project" "test" {
+			name   			 = "%[1]s"
+			org_id 			 = "%[2]s"
+			authoritative_settings = true
+			%[3]s
+		}
+	`, projectName, orgID, settings)
+}
+
 func testAccMongoDBAtlasProjectConfigWithLimits(projectName, orgID string, limits []*admin.DataFederationLimit) string {
 	var limitsString string
 
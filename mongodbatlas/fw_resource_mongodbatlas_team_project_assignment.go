@@ -0,0 +1,245 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	matlas "go.mongodb.org/atlas/mongodbatlas"
+
+	conversion "github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/framework/conversion"
+)
+
+const (
+	teamProjectAssignmentResourceName = "team_project_assignment"
+	errorTeamProjectAssignmentCreate  = "error assigning team (%s) to project (%s): %s"
+	errorTeamProjectAssignmentRead    = "error getting team (%s) assignment in project (%s): %s"
+	errorTeamProjectAssignmentUpdate  = "error updating roles of team (%s) in project (%s): %s"
+	errorTeamProjectAssignmentDelete  = "error removing team (%s) from project (%s): %s"
+)
+
+var _ resource.ResourceWithConfigure = &TeamProjectAssignmentRS{}
+var _ resource.ResourceWithImportState = &TeamProjectAssignmentRS{}
+
+func NewTeamProjectAssignmentRS() resource.Resource {
+	return &TeamProjectAssignmentRS{
+		RSCommon: RSCommon{
+			resourceName: teamProjectAssignmentResourceName,
+		},
+	}
+}
+
+type TeamProjectAssignmentRS struct {
+	RSCommon
+}
+
+type tfTeamProjectAssignmentModel struct {
+	ID        types.String `tfsdk:"id"`
+	ProjectID types.String `tfsdk:"project_id"`
+	TeamID    types.String `tfsdk:"team_id"`
+	RoleNames types.Set    `tfsdk:"role_names"`
+}
+
+func (r *TeamProjectAssignmentRS) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role_names": schema.SetAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *TeamProjectAssignmentRS) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan tfTeamProjectAssignmentModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.client.Atlas
+	projectID := plan.ProjectID.ValueString()
+	teamID := plan.TeamID.ValueString()
+
+	_, _, err := conn.Projects.AddTeamsToProject(ctx, projectID, []*matlas.ProjectTeam{
+		{
+			TeamID:    teamID,
+			RoleNames: conversion.TypesSetToString(ctx, plan.RoleNames),
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("error creating team project assignment", fmt.Sprintf(errorTeamProjectAssignmentCreate, teamID, projectID, err))
+		return
+	}
+
+	team, _, err := getProjectTeamAssigned(ctx, conn, projectID, teamID)
+	if err != nil {
+		resp.Diagnostics.AddError("error getting team project assignment after create", fmt.Sprintf(errorTeamProjectAssignmentRead, teamID, projectID, err))
+		return
+	}
+	if team == nil {
+		resp.Diagnostics.AddError("error getting team project assignment after create", fmt.Sprintf(errorTeamProjectAssignmentRead, teamID, projectID, "team is not assigned to the project"))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, newTFTeamProjectAssignmentModel(ctx, projectID, team))...)
+}
+
+func (r *TeamProjectAssignmentRS) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state tfTeamProjectAssignmentModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ids := decodeStateID(state.ID.ValueString())
+	projectID := ids["project_id"]
+	teamID := ids["team_id"]
+
+	team, httpResponse, err := getProjectTeamAssigned(ctx, r.client.Atlas, projectID, teamID)
+	if err != nil {
+		if httpResponse != nil && httpResponse.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("error getting team project assignment", fmt.Sprintf(errorTeamProjectAssignmentRead, teamID, projectID, err))
+		return
+	}
+
+	// the team was removed from the project outside of terraform
+	if team == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, newTFTeamProjectAssignmentModel(ctx, projectID, team))...)
+}
+
+func (r *TeamProjectAssignmentRS) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan tfTeamProjectAssignmentModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.client.Atlas
+	projectID := plan.ProjectID.ValueString()
+	teamID := plan.TeamID.ValueString()
+
+	_, _, err := conn.Teams.UpdateTeamRoles(ctx, projectID, teamID, &matlas.TeamUpdateRoles{
+		RoleNames: conversion.TypesSetToString(ctx, plan.RoleNames),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("error updating team project assignment", fmt.Sprintf(errorTeamProjectAssignmentUpdate, teamID, projectID, err))
+		return
+	}
+
+	team, _, err := getProjectTeamAssigned(ctx, conn, projectID, teamID)
+	if err != nil {
+		resp.Diagnostics.AddError("error getting team project assignment after update", fmt.Sprintf(errorTeamProjectAssignmentRead, teamID, projectID, err))
+		return
+	}
+	if team == nil {
+		resp.Diagnostics.AddError("error getting team project assignment after update", fmt.Sprintf(errorTeamProjectAssignmentRead, teamID, projectID, "team is not assigned to the project"))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, newTFTeamProjectAssignmentModel(ctx, projectID, team))...)
+}
+
+func (r *TeamProjectAssignmentRS) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state tfTeamProjectAssignmentModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := state.ProjectID.ValueString()
+	teamID := state.TeamID.ValueString()
+
+	httpResponse, err := r.client.Atlas.Teams.RemoveTeamFromProject(ctx, projectID, teamID)
+	if err != nil {
+		if httpResponse != nil && httpResponse.StatusCode == http.StatusNotFound {
+			return
+		}
+		resp.Diagnostics.AddError("error deleting team project assignment", fmt.Sprintf(errorTeamProjectAssignmentDelete, teamID, projectID, err))
+	}
+}
+
+func (r *TeamProjectAssignmentRS) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, "-", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError("import format error", "to import a team project assignment, use the format {project_id}-{team_id}")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), encodeStateID(map[string]string{
+		"project_id": parts[0],
+		"team_id":    parts[1],
+	}))...)
+}
+
+// getProjectTeamAssigned returns the assignment of the team in the project, or nil if the team is not assigned to it.
+func getProjectTeamAssigned(ctx context.Context, conn *matlas.Client, projectID, teamID string) (*matlas.Result, *matlas.Response, error) {
+	teams, httpResponse, err := conn.Projects.GetProjectTeamsAssigned(ctx, projectID)
+	if err != nil {
+		return nil, httpResponse, err
+	}
+
+	for _, team := range teams.Results {
+		if team.TeamID == teamID {
+			return team, httpResponse, nil
+		}
+	}
+
+	return nil, httpResponse, nil
+}
+
+func newTFTeamProjectAssignmentModel(ctx context.Context, projectID string, team *matlas.Result) *tfTeamProjectAssignmentModel {
+	roleNames, _ := types.SetValueFrom(ctx, types.StringType, team.RoleNames)
+
+	return &tfTeamProjectAssignmentModel{
+		ID: types.StringValue(encodeStateID(map[string]string{
+			"project_id": projectID,
+			"team_id":    team.TeamID,
+		})),
+		ProjectID: types.StringValue(projectID),
+		TeamID:    types.StringValue(team.TeamID),
+		RoleNames: roleNames,
+	}
+}
@@ -0,0 +1,153 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccConfigRSTeamProjectAssignment_basic(t *testing.T) {
+	var (
+		resourceName = "mongodbatlas_team_project_assignment.test"
+		projectName  = acctest.RandomWithPrefix("test-acc")
+		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		teamsIds     = strings.Split(os.Getenv("MONGODB_ATLAS_TEAMS_IDS"), ",")
+	)
+	if len(teamsIds) < 2 {
+		t.Skip("`MONGODB_ATLAS_TEAMS_IDS` must have 2 team ids for this acceptance testing")
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t); testCheckTeamsIds(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasTeamProjectAssignmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasTeamProjectAssignmentConfig(projectName, orgID, teamsIds[0], teamsIds[1], `["GROUP_READ_ONLY"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasTeamProjectAssignmentExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "project_id"),
+					resource.TestCheckResourceAttr(resourceName, "team_id", teamsIds[1]),
+					resource.TestCheckResourceAttr(resourceName, "role_names.#", "1"),
+					resource.TestCheckResourceAttr("mongodbatlas_project.test", "teams.#", "1"),
+				),
+			},
+			{
+				Config: testAccMongoDBAtlasTeamProjectAssignmentConfig(projectName, orgID, teamsIds[0], teamsIds[1], `["GROUP_READ_ONLY", "GROUP_DATA_ACCESS_READ_ONLY"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasTeamProjectAssignmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "team_id", teamsIds[1]),
+					resource.TestCheckResourceAttr(resourceName, "role_names.#", "2"),
+					resource.TestCheckResourceAttr("mongodbatlas_project.test", "teams.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccConfigRSTeamProjectAssignment_importBasic(t *testing.T) {
+	var (
+		resourceName = "mongodbatlas_team_project_assignment.test"
+		projectName  = acctest.RandomWithPrefix("test-acc")
+		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		teamsIds     = strings.Split(os.Getenv("MONGODB_ATLAS_TEAMS_IDS"), ",")
+	)
+	if len(teamsIds) < 2 {
+		t.Skip("`MONGODB_ATLAS_TEAMS_IDS` must have 2 team ids for this acceptance testing")
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t); testCheckTeamsIds(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasTeamProjectAssignmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasTeamProjectAssignmentConfig(projectName, orgID, teamsIds[0], teamsIds[1], `["GROUP_READ_ONLY"]`),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateIdFunc: testAccCheckMongoDBAtlasTeamProjectAssignmentImportStateIDFunc(resourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckMongoDBAtlasTeamProjectAssignmentExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testMongoDBClient.(*MongoDBClient).Atlas
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		ids := decodeStateID(rs.Primary.ID)
+		team, _, err := getProjectTeamAssigned(context.Background(), conn, ids["project_id"], ids["team_id"])
+		if err != nil || team == nil {
+			return fmt.Errorf("team (%s) is not assigned to project (%s)", ids["team_id"], ids["project_id"])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckMongoDBAtlasTeamProjectAssignmentDestroy(s *terraform.State) error {
+	conn := testMongoDBClient.(*MongoDBClient).Atlas
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "mongodbatlas_team_project_assignment" {
+			continue
+		}
+
+		ids := decodeStateID(rs.Primary.ID)
+		team, _, _ := getProjectTeamAssigned(context.Background(), conn, ids["project_id"], ids["team_id"])
+		if team != nil {
+			return fmt.Errorf("team (%s) is still assigned to project (%s)", ids["team_id"], ids["project_id"])
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckMongoDBAtlasTeamProjectAssignmentImportStateIDFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s-%s", rs.Primary.Attributes["project_id"], rs.Primary.Attributes["team_id"]), nil
+	}
+}
+
+func testAccMongoDBAtlasTeamProjectAssignmentConfig(projectName, orgID, ownerTeamID, teamID, roleNames string) string {
+	return fmt.Sprintf(`
+		resource "mongodbatlas_project" "test" {
+			name                   = %[1]q
+			org_id                 = %[2]q
+			ignore_unmanaged_teams = true
+
+			teams {
+				team_id    = %[3]q
+				role_names = ["GROUP_OWNER"]
+			}
+		}
+
+		resource "mongodbatlas_team_project_assignment" "test" {
+			project_id = mongodbatlas_project.test.id
+			team_id    = %[4]q
+			role_names = %[5]s
+		}
+	`, projectName, orgID, ownerTeamID, teamID, roleNames)
+}
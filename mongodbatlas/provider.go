@@ -111,34 +111,37 @@ func NewSdkV2Provider() *schema.Provider {
 
 func getDataSourcesMap() map[string]*schema.Resource {
 	dataSourcesMap := map[string]*schema.Resource{
-		"mongodbatlas_advanced_cluster":                  dataSourceMongoDBAtlasAdvancedCluster(),
-		"mongodbatlas_advanced_clusters":                 dataSourceMongoDBAtlasAdvancedClusters(),
-		"mongodbatlas_custom_db_role":                    dataSourceMongoDBAtlasCustomDBRole(),
-		"mongodbatlas_custom_db_roles":                   dataSourceMongoDBAtlasCustomDBRoles(),
-		"mongodbatlas_api_key":                           dataSourceMongoDBAtlasAPIKey(),
-		"mongodbatlas_api_keys":                          dataSourceMongoDBAtlasAPIKeys(),
-		"mongodbatlas_access_list_api_key":               dataSourceMongoDBAtlasAccessListAPIKey(),
-		"mongodbatlas_access_list_api_keys":              dataSourceMongoDBAtlasAccessListAPIKeys(),
-		"mongodbatlas_project_api_key":                   dataSourceMongoDBAtlasProjectAPIKey(),
-		"mongodbatlas_project_api_keys":                  dataSourceMongoDBAtlasProjectAPIKeys(),
-		"mongodbatlas_roles_org_id":                      dataSourceMongoDBAtlasOrgID(),
-		"mongodbatlas_cluster":                           dataSourceMongoDBAtlasCluster(),
-		"mongodbatlas_clusters":                          dataSourceMongoDBAtlasClusters(),
-		"mongodbatlas_network_container":                 dataSourceMongoDBAtlasNetworkContainer(),
-		"mongodbatlas_network_containers":                dataSourceMongoDBAtlasNetworkContainers(),
-		"mongodbatlas_network_peering":                   dataSourceMongoDBAtlasNetworkPeering(),
-		"mongodbatlas_network_peerings":                  dataSourceMongoDBAtlasNetworkPeerings(),
-		"mongodbatlas_maintenance_window":                dataSourceMongoDBAtlasMaintenanceWindow(),
-		"mongodbatlas_auditing":                          dataSourceMongoDBAtlasAuditing(),
-		"mongodbatlas_team":                              dataSourceMongoDBAtlasTeam(),
-		"mongodbatlas_teams":                             dataSourceMongoDBAtlasTeam(),
-		"mongodbatlas_global_cluster_config":             dataSourceMongoDBAtlasGlobalCluster(),
-		"mongodbatlas_x509_authentication_database_user": dataSourceMongoDBAtlasX509AuthDBUser(),
-		"mongodbatlas_private_endpoint_regional_mode":    dataSourceMongoDBAtlasPrivateEndpointRegionalMode(),
+		"mongodbatlas_advanced_cluster":                   dataSourceMongoDBAtlasAdvancedCluster(),
+		"mongodbatlas_advanced_clusters":                  dataSourceMongoDBAtlasAdvancedClusters(),
+		"mongodbatlas_custom_db_role":                     dataSourceMongoDBAtlasCustomDBRole(),
+		"mongodbatlas_custom_db_roles":                    dataSourceMongoDBAtlasCustomDBRoles(),
+		"mongodbatlas_api_key":                            dataSourceMongoDBAtlasAPIKey(),
+		"mongodbatlas_api_keys":                           dataSourceMongoDBAtlasAPIKeys(),
+		"mongodbatlas_access_list_api_key":                dataSourceMongoDBAtlasAccessListAPIKey(),
+		"mongodbatlas_access_list_api_keys":               dataSourceMongoDBAtlasAccessListAPIKeys(),
+		"mongodbatlas_project_api_key":                    dataSourceMongoDBAtlasProjectAPIKey(),
+		"mongodbatlas_project_api_keys":                   dataSourceMongoDBAtlasProjectAPIKeys(),
+		"mongodbatlas_roles_org_id":                       dataSourceMongoDBAtlasOrgID(),
+		"mongodbatlas_cluster":                            dataSourceMongoDBAtlasCluster(),
+		"mongodbatlas_clusters":                           dataSourceMongoDBAtlasClusters(),
+		"mongodbatlas_network_container":                  dataSourceMongoDBAtlasNetworkContainer(),
+		"mongodbatlas_network_containers":                 dataSourceMongoDBAtlasNetworkContainers(),
+		"mongodbatlas_network_container_free_cidr_blocks": dataSourceMongoDBAtlasNetworkContainerFreeCIDRBlocks(),
+		"mongodbatlas_network_peering":                    dataSourceMongoDBAtlasNetworkPeering(),
+		"mongodbatlas_network_peerings":                   dataSourceMongoDBAtlasNetworkPeerings(),
+		"mongodbatlas_maintenance_window":                 dataSourceMongoDBAtlasMaintenanceWindow(),
+		"mongodbatlas_auditing":                           dataSourceMongoDBAtlasAuditing(),
+		"mongodbatlas_team":                               dataSourceMongoDBAtlasTeam(),
+		"mongodbatlas_teams":                              dataSourceMongoDBAtlasTeam(),
+		"mongodbatlas_global_cluster_config":              dataSourceMongoDBAtlasGlobalCluster(),
+		"mongodbatlas_x509_authentication_database_user":  dataSourceMongoDBAtlasX509AuthDBUser(),
+		"mongodbatlas_private_endpoint_regional_mode":     dataSourceMongoDBAtlasPrivateEndpointRegionalMode(),
 		"mongodbatlas_privatelink_endpoint_service_data_federation_online_archive":  dataSourceMongoDBAtlasPrivatelinkEndpointServiceDataFederationOnlineArchive(),
 		"mongodbatlas_privatelink_endpoint_service_data_federation_online_archives": dataSourceMongoDBAtlasPrivatelinkEndpointServiceDataFederationOnlineArchives(),
 		"mongodbatlas_privatelink_endpoint":                                         dataSourceMongoDBAtlasPrivateLinkEndpoint(),
 		"mongodbatlas_privatelink_endpoint_service":                                 dataSourceMongoDBAtlasPrivateEndpointServiceLink(),
+		"mongodbatlas_private_endpoint_connection_string":                           dataSourceMongoDBAtlasPrivateEndpointConnectionString(),
+		"mongodbatlas_network_container_by_region":                                  dataSourceMongoDBAtlasNetworkContainerByRegion(),
 		"mongodbatlas_privatelink_endpoint_service_serverless":                      dataSourceMongoDBAtlasPrivateLinkEndpointServerless(),
 		"mongodbatlas_privatelink_endpoints_service_serverless":                     dataSourceMongoDBAtlasPrivateLinkEndpointsServiceServerless(),
 		"mongodbatlas_cloud_backup_schedule":                                        dataSourceMongoDBAtlasCloudBackupSchedule(),
@@ -166,6 +169,7 @@ func getDataSourcesMap() map[string]*schema.Resource {
 		"mongodbatlas_cloud_backup_snapshot":                                        dataSourceMongoDBAtlasCloudBackupSnapshot(),
 		"mongodbatlas_cloud_backup_snapshots":                                       dataSourceMongoDBAtlasCloudBackupSnapshots(),
 		"mongodbatlas_backup_compliance_policy":                                     dataSourceMongoDBAtlasBackupCompliancePolicy(),
+		"mongodbatlas_backup_compliance_policy_status":                              dataSourceMongoDBAtlasBackupCompliancePolicyStatus(),
 		"mongodbatlas_cloud_backup_snapshot_restore_job":                            dataSourceMongoDBAtlasCloudBackupSnapshotRestoreJob(),
 		"mongodbatlas_cloud_backup_snapshot_restore_jobs":                           dataSourceMongoDBAtlasCloudBackupSnapshotRestoreJobs(),
 		"mongodbatlas_cloud_backup_snapshot_export_bucket":                          datasourceMongoDBAtlasCloudBackupSnapshotExportBucket(),
@@ -185,6 +189,10 @@ func getDataSourcesMap() map[string]*schema.Resource {
 		"mongodbatlas_federated_query_limits":                                       dataSourceMongoDBAtlasFederatedDatabaseQueryLimits(),
 		"mongodbatlas_serverless_instance":                                          dataSourceMongoDBAtlasServerlessInstance(),
 		"mongodbatlas_serverless_instances":                                         dataSourceMongoDBAtlasServerlessInstances(),
+		"mongodbatlas_serverless_snapshot":                                          dataSourceMongoDBAtlasServerlessSnapshot(),
+		"mongodbatlas_serverless_snapshots":                                         dataSourceMongoDBAtlasServerlessSnapshots(),
+		"mongodbatlas_serverless_restore_job":                                       dataSourceMongoDBAtlasServerlessRestoreJob(),
+		"mongodbatlas_serverless_restore_jobs":                                      dataSourceMongoDBAtlasServerlessRestoreJobs(),
 		"mongodbatlas_cluster_outage_simulation":                                    dataSourceMongoDBAtlasClusterOutageSimulation(),
 		"mongodbatlas_shared_tier_restore_job":                                      dataSourceMongoDBAtlasCloudSharedTierRestoreJob(),
 		"mongodbatlas_shared_tier_restore_jobs":                                     dataSourceMongoDBAtlasCloudSharedTierRestoreJobs(),
@@ -232,7 +240,6 @@ func getResourcesMap() map[string]*schema.Resource {
 		"mongodbatlas_org_invitation":                                              resourceMongoDBAtlasOrgInvitation(),
 		"mongodbatlas_organization":                                                resourceMongoDBAtlasOrganization(),
 		"mongodbatlas_cloud_backup_snapshot":                                       resourceMongoDBAtlasCloudBackupSnapshot(),
-		"mongodbatlas_backup_compliance_policy":                                    resourceMongoDBAtlasBackupCompliancePolicy(),
 		"mongodbatlas_cloud_backup_snapshot_restore_job":                           resourceMongoDBAtlasCloudBackupSnapshotRestoreJob(),
 		"mongodbatlas_cloud_backup_snapshot_export_bucket":                         resourceMongoDBAtlasCloudBackupSnapshotExportBucket(),
 		"mongodbatlas_cloud_backup_snapshot_export_job":                            resourceMongoDBAtlasCloudBackupSnapshotExportJob(),
@@ -242,6 +249,7 @@ func getResourcesMap() map[string]*schema.Resource {
 		"mongodbatlas_federated_database_instance":                                 resourceMongoDBAtlasFederatedDatabaseInstance(),
 		"mongodbatlas_federated_query_limit":                                       resourceMongoDBAtlasFederatedDatabaseQueryLimit(),
 		"mongodbatlas_serverless_instance":                                         resourceMongoDBAtlasServerlessInstance(),
+		"mongodbatlas_serverless_restore_job":                                      resourceMongoDBAtlasServerlessRestoreJob(),
 		"mongodbatlas_cluster_outage_simulation":                                   resourceMongoDBAtlasClusterOutageSimulation(),
 	}
 	return resourcesMap
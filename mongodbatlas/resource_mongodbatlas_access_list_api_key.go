@@ -38,25 +38,7 @@ func resourceMongoDBAtlasAccessListAPIKey() *schema.Resource {
 				Computed:      true,
 				ForceNew:      true,
 				ConflictsWith: []string{"ip_address"},
-				ValidateFunc: func(i interface{}, k string) (s []string, es []error) {
-					v, ok := i.(string)
-					if !ok {
-						es = append(es, fmt.Errorf("expected type of %s to be string", k))
-						return
-					}
-
-					_, ipnet, err := net.ParseCIDR(v)
-					if err != nil {
-						es = append(es, fmt.Errorf("expected %s to contain a valid CIDR, got: %s with err: %s", k, v, err))
-						return
-					}
-
-					if ipnet == nil || v != ipnet.String() {
-						es = append(es, fmt.Errorf("expected %s to contain a valid network CIDR, expected %s, got %s", k, ipnet, v))
-						return
-					}
-					return
-				},
+				ValidateFunc:  validateAccessListAPIKeyCIDRBlock,
 			},
 			"ip_address": {
 				Type:          schema.TypeString,
@@ -229,3 +211,174 @@ func flattenAccessListAPIKeys(ctx context.Context, conn *matlas.Client, orgID st
 	}
 	return results
 }
+
+func validateAccessListAPIKeyCIDRBlock(i interface{}, k string) (s []string, es []error) {
+	v, ok := i.(string)
+	if !ok {
+		es = append(es, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	_, ipnet, err := net.ParseCIDR(v)
+	if err != nil {
+		es = append(es, fmt.Errorf("expected %s to contain a valid CIDR, got: %s with err: %s", k, v, err))
+		return
+	}
+
+	if ipnet == nil || v != ipnet.String() {
+		es = append(es, fmt.Errorf("expected %s to contain a valid network CIDR, expected %s, got %s", k, ipnet, v))
+		return
+	}
+	return
+}
+
+// apiKeyAccessListSchema is the inline `access_list` block of the api key resources. It's computed so the entries
+// are always read, and drift is detected, even when the block isn't defined in the configuration.
+func apiKeyAccessListSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"cidr_block": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validateAccessListAPIKeyCIDRBlock,
+				},
+				"ip_address": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.IsIPAddress,
+				},
+			},
+		},
+	}
+}
+
+// accessListAPIKeyEntry returns the identifier of an access list entry, as expected by the Atlas API to get or delete it.
+// CIDR blocks of a single address are stored by Atlas as an ip address.
+func accessListAPIKeyEntry(ipAddress, cidrBlock string) string {
+	if ipAddress != "" {
+		return ipAddress
+	}
+	if strings.HasSuffix(cidrBlock, "/32") {
+		return strings.TrimSuffix(cidrBlock, "/32")
+	}
+	return cidrBlock
+}
+
+func expandAPIKeyAccessList(accessList *schema.Set) map[string]*matlas.AccessListAPIKeysReq {
+	entries := make(map[string]*matlas.AccessListAPIKeysReq, accessList.Len())
+	for _, v := range accessList.List() {
+		item := v.(map[string]interface{})
+		ipAddress := item["ip_address"].(string)
+		cidrBlock := item["cidr_block"].(string)
+		if ipAddress == "" && cidrBlock == "" {
+			continue
+		}
+		entries[accessListAPIKeyEntry(ipAddress, cidrBlock)] = &matlas.AccessListAPIKeysReq{
+			IPAddress: ipAddress,
+			CidrBlock: cidrBlock,
+		}
+	}
+	return entries
+}
+
+func resourceAPIKeyAccessListCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if accessList, ok := d.GetOk("access_list"); ok {
+		return validateAPIKeyAccessList(accessList.(*schema.Set))
+	}
+	return nil
+}
+
+func validateAPIKeyAccessList(accessList *schema.Set) error {
+	for _, v := range accessList.List() {
+		item := v.(map[string]interface{})
+		ipAddress := item["ip_address"].(string)
+		cidrBlock := item["cidr_block"].(string)
+		if (ipAddress == "") == (cidrBlock == "") {
+			return errors.New("each `access_list` entry must define exactly one of `ip_address` or `cidr_block`")
+		}
+	}
+	return nil
+}
+
+func createAPIKeyAccessList(ctx context.Context, conn *matlas.Client, orgID, apiKeyID string, accessList *schema.Set) error {
+	entries := expandAPIKeyAccessList(accessList)
+	if len(entries) == 0 {
+		return nil
+	}
+
+	createRequest := make([]*matlas.AccessListAPIKeysReq, 0, len(entries))
+	for _, entry := range entries {
+		createRequest = append(createRequest, entry)
+	}
+
+	if _, _, err := conn.AccessListAPIKeys.Create(ctx, orgID, apiKeyID, createRequest); err != nil {
+		return fmt.Errorf("error creating access list of API key (%s): %s", apiKeyID, err)
+	}
+	return nil
+}
+
+// updateAPIKeyAccessList reconciles the access list of the api key with the entries of the configuration.
+// New entries are added before removing the old ones so the key is never left without restrictions.
+func updateAPIKeyAccessList(ctx context.Context, conn *matlas.Client, orgID, apiKeyID string, d *schema.ResourceData) error {
+	oldAccessList, newAccessList := d.GetChange("access_list")
+	oldEntries := expandAPIKeyAccessList(oldAccessList.(*schema.Set))
+	newEntries := expandAPIKeyAccessList(newAccessList.(*schema.Set))
+
+	createRequest := []*matlas.AccessListAPIKeysReq{}
+	for key, entry := range newEntries {
+		if _, ok := oldEntries[key]; !ok {
+			createRequest = append(createRequest, entry)
+		}
+	}
+	if len(createRequest) > 0 {
+		if _, _, err := conn.AccessListAPIKeys.Create(ctx, orgID, apiKeyID, createRequest); err != nil {
+			return fmt.Errorf("error adding entries to the access list of API key (%s): %s", apiKeyID, err)
+		}
+	}
+
+	for key := range oldEntries {
+		if _, ok := newEntries[key]; ok {
+			continue
+		}
+		resp, err := conn.AccessListAPIKeys.Delete(ctx, orgID, apiKeyID, strings.ReplaceAll(key, "/", "%2F"))
+		if err != nil && (resp == nil || resp.StatusCode != http.StatusNotFound) {
+			return fmt.Errorf("error removing entry (%s) from the access list of API key (%s): %s", key, apiKeyID, err)
+		}
+	}
+	return nil
+}
+
+// flattenAPIKeyAccessList returns the access list of the api key. Atlas reports single addresses both as ip address
+// and as CIDR block, so the form used in the configuration is kept to avoid diffs.
+func flattenAPIKeyAccessList(ctx context.Context, conn *matlas.Client, orgID, apiKeyID string, configured *schema.Set) ([]map[string]interface{}, error) {
+	accessList, _, err := conn.AccessListAPIKeys.List(ctx, orgID, apiKeyID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting access list of API key (%s): %s", apiKeyID, err)
+	}
+
+	configuredCIDRBlocks := map[string]bool{}
+	for _, v := range configured.List() {
+		if cidrBlock := v.(map[string]interface{})["cidr_block"].(string); cidrBlock != "" {
+			configuredCIDRBlocks[cidrBlock] = true
+		}
+	}
+
+	results := make([]map[string]interface{}, 0, len(accessList.Results))
+	for _, entry := range accessList.Results {
+		result := map[string]interface{}{
+			"ip_address": "",
+			"cidr_block": "",
+		}
+		if entry.IPAddress != "" && !configuredCIDRBlocks[entry.CidrBlock] {
+			result["ip_address"] = entry.IPAddress
+		} else {
+			result["cidr_block"] = entry.CidrBlock
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
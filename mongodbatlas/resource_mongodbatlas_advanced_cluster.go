@@ -47,6 +47,7 @@ func resourceMongoDBAtlasAdvancedCluster() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceMongoDBAtlasAdvancedClusterImportState,
 		},
+		CustomizeDiff: clusterPitEnabledComplianceCustomizeDiff,
 		SchemaVersion: 1,
 		StateUpgraders: []schema.StateUpgrader{
 			{
@@ -161,6 +162,7 @@ func resourceMongoDBAtlasAdvancedCluster() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"pre_change_snapshot": preChangeSnapshotSchema(),
 			"replication_specs": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -719,6 +721,13 @@ func resourceMongoDBAtlasAdvancedClusterUpdate(ctx context.Context, d *schema.Re
 
 	timeout := d.Timeout(schema.TimeoutUpdate)
 
+	if !reflect.DeepEqual(cluster, clusterChangeDetect) && d.HasChanges(advancedClusterPreChangeSnapshotAttributes...) {
+		backupEnabled, _ := d.GetChange("backup_enabled")
+		if err := takeClusterPreChangeSnapshot(ctx, conn, d, projectID, clusterName, backupEnabled.(bool), timeout); err != nil {
+			return diag.FromErr(fmt.Errorf(errorClusterAdvancedUpdate, clusterName, err))
+		}
+	}
+
 	if d.HasChange("advanced_configuration") {
 		ac := d.Get("advanced_configuration")
 		if aclist, ok := ac.([]interface{}); ok && len(aclist) > 0 {
@@ -772,6 +781,83 @@ func resourceMongoDBAtlasAdvancedClusterUpdate(ctx context.Context, d *schema.Re
 	return resourceMongoDBAtlasAdvancedClusterRead(ctx, d, meta)
 }
 
+// advancedClusterPreChangeSnapshotAttributes are the attributes whose changes can't be undone without restoring
+// the cluster, so they trigger the pre-change snapshot.
+var advancedClusterPreChangeSnapshotAttributes = []string{
+	"cluster_type",
+	"disk_size_gb",
+	"encryption_at_rest_provider",
+	"mongo_db_major_version",
+	"replication_specs",
+}
+
+func preChangeSnapshotSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"retention_in_days": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"description": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"last_snapshot_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+// takeClusterPreChangeSnapshot takes an on-demand snapshot of the cluster if pre_change_snapshot is set, backupEnabled
+// is true and the cluster isn't paused. The caller checks that the attributes that trigger the snapshot have changed.
+func takeClusterPreChangeSnapshot(ctx context.Context, conn *matlas.Client, d *schema.ResourceData, projectID, clusterName string, backupEnabled bool, timeout time.Duration) error {
+	v, ok := d.GetOk("pre_change_snapshot")
+	if !ok {
+		return nil
+	}
+
+	paused, _ := d.GetChange("paused")
+	if !backupEnabled || paused.(bool) {
+		log.Printf("[WARN] skipping the pre-change snapshot of cluster (%s), backup is not enabled or the cluster is paused", clusterName)
+		return nil
+	}
+
+	tfMap := v.([]interface{})[0].(map[string]interface{})
+	description := tfMap["description"].(string)
+	if description == "" {
+		description = fmt.Sprintf("Taken by Terraform before changing cluster %s", clusterName)
+	}
+
+	requestParameters := &matlas.SnapshotReqPathParameters{
+		GroupID:     projectID,
+		ClusterName: clusterName,
+	}
+	snapshotReq := &matlas.CloudProviderSnapshot{
+		Description:     description,
+		RetentionInDays: tfMap["retention_in_days"].(int),
+	}
+
+	snapshot, err := takeCloudBackupSnapshot(ctx, conn, requestParameters, snapshotReq, timeout)
+	if err != nil {
+		return fmt.Errorf("error taking the pre-change snapshot: %s", err)
+	}
+
+	tfMap["last_snapshot_id"] = snapshot.ID
+	if err := d.Set("pre_change_snapshot", []interface{}{tfMap}); err != nil {
+		return fmt.Errorf("error setting `pre_change_snapshot` for cluster (%s): %s", clusterName, err)
+	}
+
+	return nil
+}
+
 func resourceMongoDBAtlasAdvancedClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Get client connection.
 	conn := meta.(*MongoDBClient).Atlas
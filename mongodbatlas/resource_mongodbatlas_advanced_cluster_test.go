@@ -126,6 +126,40 @@ func TestAccClusterAdvancedCluster_singleProvider(t *testing.T) {
 	})
 }
 
+func TestAccClusterAdvancedCluster_preChangeSnapshot(t *testing.T) {
+	var (
+		cluster      matlas.AdvancedCluster
+		resourceName = "mongodbatlas_advanced_cluster.test"
+		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		projectName  = acctest.RandomWithPrefix("test-acc")
+		rName        = acctest.RandomWithPrefix("test-acc")
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasAdvancedClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasAdvancedClusterConfigPreChangeSnapshot(orgID, projectName, rName, "M10"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasAdvancedClusterExists(resourceName, &cluster),
+					resource.TestCheckResourceAttr(resourceName, "pre_change_snapshot.0.retention_in_days", "3"),
+					resource.TestCheckResourceAttr(resourceName, "pre_change_snapshot.0.last_snapshot_id", ""),
+				),
+			},
+			{
+				Config: testAccMongoDBAtlasAdvancedClusterConfigPreChangeSnapshot(orgID, projectName, rName, "M20"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasAdvancedClusterExists(resourceName, &cluster),
+					resource.TestCheckResourceAttr(resourceName, "replication_specs.0.region_configs.0.electable_specs.0.instance_size", "M20"),
+					resource.TestCheckResourceAttrSet(resourceName, "pre_change_snapshot.0.last_snapshot_id"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccClusterAdvancedCluster_multicloud(t *testing.T) {
 	var (
 		cluster                matlas.AdvancedCluster
@@ -871,6 +905,37 @@ data "mongodbatlas_advanced_cluster" "test" {
 	`, orgID, projectName, name)
 }
 
+func testAccMongoDBAtlasAdvancedClusterConfigPreChangeSnapshot(orgID, projectName, name, instanceSize string) string {
+	return fmt.Sprintf(`
+resource "mongodbatlas_project" "cluster_project" {
+	name   = %[2]q
+	org_id = %[1]q
+}
+resource "mongodbatlas_advanced_cluster" "test" {
+  project_id     = mongodbatlas_project.cluster_project.id
+  name           = %[3]q
+  cluster_type   = "REPLICASET"
+  backup_enabled = true
+
+  pre_change_snapshot {
+    retention_in_days = 3
+  }
+
+  replication_specs {
+    region_configs {
+      electable_specs {
+        instance_size = %[4]q
+        node_count    = 3
+      }
+      provider_name = "AWS"
+      priority      = 7
+      region_name   = "US_EAST_1"
+    }
+  }
+}
+	`, orgID, projectName, name, instanceSize)
+}
+
 func testAccMongoDBAtlasAdvancedClusterConfigMultiCloud(orgID, projectName, name string) string {
 	return fmt.Sprintf(`
 resource "mongodbatlas_project" "cluster_project" {
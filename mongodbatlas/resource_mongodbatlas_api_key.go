@@ -22,6 +22,7 @@ func resourceMongoDBAtlasAPIKey() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceMongoDBAtlasAPIKeyImportState,
 		},
+		CustomizeDiff: resourceAPIKeyAccessListCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"org_id": {
 				Type:     schema.TypeString,
@@ -51,6 +52,12 @@ func resourceMongoDBAtlasAPIKey() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"access_list": apiKeyAccessListSchema(),
+			"rotation_trigger": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
 		},
 	}
 }
@@ -74,6 +81,14 @@ func resourceMongoDBAtlasAPIKeyCreate(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(fmt.Errorf("error create API key: %s", err))
 	}
 
+	// the key is removed if its access list can't be created, so it's never left without the expected restrictions
+	if err := createAPIKeyAccessList(ctx, conn, orgID, apiKey.ID, d.Get("access_list").(*schema.Set)); err != nil {
+		if _, errDelete := conn.APIKeys.Delete(ctx, orgID, apiKey.ID); errDelete != nil {
+			return diag.FromErr(fmt.Errorf("%s, and the API key (%s) couldn't be removed: %s", err, apiKey.ID, errDelete))
+		}
+		return diag.FromErr(err)
+	}
+
 	if err := d.Set("private_key", apiKey.PrivateKey); err != nil {
 		return diag.FromErr(fmt.Errorf("error setting `public_key`: %s", err))
 	}
@@ -119,6 +134,14 @@ func resourceMongoDBAtlasAPIKeyRead(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(fmt.Errorf("error setting `roles`: %s", err))
 	}
 
+	accessList, err := flattenAPIKeyAccessList(ctx, conn, orgID, apiKeyID, d.Get("access_list").(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("access_list", accessList); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting `access_list`: %s", err))
+	}
+
 	return nil
 }
 
@@ -141,6 +164,12 @@ func resourceMongoDBAtlasAPIKeyUpdate(ctx context.Context, d *schema.ResourceDat
 		}
 	}
 
+	if d.HasChange("access_list") {
+		if err := updateAPIKeyAccessList(ctx, conn, orgID, apiKeyID, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceMongoDBAtlasAPIKeyRead(ctx, d, meta)
 }
 
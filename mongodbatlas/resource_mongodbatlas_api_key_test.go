@@ -76,6 +76,62 @@ func TestAccConfigRSAPIKey_importBasic(t *testing.T) {
 	})
 }
 
+func TestAccConfigRSAPIKey_AccessList(t *testing.T) {
+	var (
+		resourceName = "mongodbatlas_api_key.test"
+		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		description  = fmt.Sprintf("test-acc-api_key-%s", acctest.RandString(5))
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasAPIKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasAPIKeyConfigAccessList(orgID, description, "rotation-1", `
+					access_list {
+						ip_address = "179.154.226.10"
+					}
+					access_list {
+						cidr_block = "179.154.228.0/24"
+					}
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasAPIKeyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "access_list.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "access_list.*", map[string]string{"ip_address": "179.154.226.10"}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "access_list.*", map[string]string{"cidr_block": "179.154.228.0/24"}),
+				),
+			},
+			{
+				Config: testAccMongoDBAtlasAPIKeyConfigAccessList(orgID, description, "rotation-1", `
+					access_list {
+						cidr_block = "179.154.229.0/24"
+					}
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasAPIKeyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "access_list.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "access_list.*", map[string]string{"cidr_block": "179.154.229.0/24"}),
+				),
+			},
+			{
+				Config: testAccMongoDBAtlasAPIKeyConfigAccessList(orgID, description, "rotation-2", `
+					access_list {
+						cidr_block = "179.154.229.0/24"
+					}
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasAPIKeyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rotation_trigger", "rotation-2"),
+					resource.TestCheckResourceAttr(resourceName, "access_list.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckMongoDBAtlasAPIKeyExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := testAccProviderSdkV2.Meta().(*MongoDBClient).Atlas
@@ -140,3 +196,20 @@ func testAccMongoDBAtlasAPIKeyConfigBasic(orgID, description, roleNames string)
 		}
 	`, orgID, description, roleNames)
 }
+
+func testAccMongoDBAtlasAPIKeyConfigAccessList(orgID, description, rotationTrigger, accessList string) string {
+	return fmt.Sprintf(`
+		resource "mongodbatlas_api_key" "test" {
+			org_id           = %[1]q
+			description      = %[2]q
+			role_names       = ["ORG_MEMBER"]
+			rotation_trigger = %[3]q
+
+			%[4]s
+
+			lifecycle {
+				create_before_destroy = true
+			}
+		}
+	`, orgID, description, rotationTrigger, accessList)
+}
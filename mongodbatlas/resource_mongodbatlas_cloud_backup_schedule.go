@@ -5,10 +5,13 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/mwielbut/pointy"
 	"github.com/spf13/cast"
 	matlas "go.mongodb.org/atlas/mongodbatlas"
@@ -33,6 +36,7 @@ func resourceMongoDBAtlasCloudBackupSchedule() *schema.Resource {
 		ReadContext:   resourceMongoDBAtlasCloudBackupScheduleRead,
 		UpdateContext: resourceMongoDBAtlasCloudBackupScheduleUpdate,
 		DeleteContext: resourceMongoDBAtlasCloudBackupScheduleDelete,
+		CustomizeDiff: resourceMongoDBAtlasCloudBackupScheduleCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceMongoDBAtlasCloudBackupScheduleImportState,
 		},
@@ -76,7 +80,8 @@ func resourceMongoDBAtlasCloudBackupSchedule() *schema.Resource {
 							Optional: true,
 							Computed: true,
 							Elem: &schema.Schema{
-								Type: schema.TypeString,
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{"HOURLY", "DAILY", "WEEKLY", "MONTHLY", "YEARLY", "ON_DEMAND"}, false),
 							},
 						},
 						"region_name": {
@@ -89,6 +94,15 @@ func resourceMongoDBAtlasCloudBackupSchedule() *schema.Resource {
 							Optional: true,
 							Computed: true,
 						},
+						"zone_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"all_replication_specs": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
 						"should_copy_oplogs": {
 							Type:     schema.TypeBool,
 							Optional: true,
@@ -266,7 +280,39 @@ func resourceMongoDBAtlasCloudBackupSchedule() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"adopt_existing_policy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			// Only computed
+			"removed_policy_items": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"frequency_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"frequency_interval": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"retention_unit": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"retention_value": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"cluster_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -289,7 +335,10 @@ func resourceMongoDBAtlasCloudBackupScheduleCreate(ctx context.Context, d *schem
 	// MongoDB Atlas automatically generates a default backup policy for that cluster.
 	// As a result, we need to first delete the default policies to avoid having
 	// the infrastructure differs from the TF configuration file.
-	if _, _, err := conn.CloudProviderSnapshotBackupPolicies.Delete(ctx, projectID, clusterName); err != nil {
+	// In adoption mode the existing policy items are updated in place instead, see adoptPolicyItems.
+	if d.Get("adopt_existing_policy").(bool) {
+		log.Printf("[DEBUG] adopting the existing MongoDB Cloud Backup Schedule (%s)", clusterName)
+	} else if _, _, err := conn.CloudProviderSnapshotBackupPolicies.Delete(ctx, projectID, clusterName); err != nil {
 		diagWarning := diag.Diagnostic{
 			Severity: diag.Warning,
 			Summary:  "Error deleting default backup schedule",
@@ -376,7 +425,16 @@ func resourceMongoDBAtlasCloudBackupScheduleRead(ctx context.Context, d *schema.
 		return diag.Errorf(errorSnapshotBackupScheduleSetting, "policy_item_monthly", clusterName, err)
 	}
 
-	if err := d.Set("copy_settings", flattenCopySettings(backupPolicy.CopySettings)); err != nil {
+	var replicationSpecs []*matlas.AdvancedReplicationSpec
+	if len(backupPolicy.CopySettings) > 0 {
+		cluster, _, err := conn.AdvancedClusters.Get(ctx, projectID, clusterName)
+		if err != nil {
+			return diag.Errorf(errorSnapshotBackupScheduleRead, clusterName, err)
+		}
+		replicationSpecs = cluster.ReplicationSpecs
+	}
+
+	if err := d.Set("copy_settings", flattenCopySettingsByTarget(backupPolicy.CopySettings, d.Get("copy_settings").([]interface{}), replicationSpecs)); err != nil {
 		return diag.Errorf(errorSnapshotBackupScheduleSetting, "copy_settings", clusterName, err)
 	}
 
@@ -411,6 +469,13 @@ func resourceMongoDBAtlasCloudBackupScheduleDelete(ctx context.Context, d *schem
 	projectID := ids["project_id"]
 	clusterName := ids["cluster_name"]
 
+	// an adopted schedule was not created by Terraform, so it's left as it is in Atlas
+	if d.Get("adopt_existing_policy").(bool) {
+		log.Printf("[DEBUG] removing the adopted MongoDB Cloud Backup Schedule (%s) from the state without resetting it", clusterName)
+		d.SetId("")
+		return nil
+	}
+
 	_, _, err := conn.CloudProviderSnapshotBackupPolicies.Delete(ctx, projectID, clusterName)
 	if err != nil {
 		return diag.Errorf("error deleting MongoDB Cloud Backup Schedule (%s): %s", clusterName, err)
@@ -445,6 +510,10 @@ func resourceMongoDBAtlasCloudBackupScheduleImportState(ctx context.Context, d *
 		return nil, fmt.Errorf(errorSnapshotBackupScheduleSetting, "cluster_name", clusterName, err)
 	}
 
+	if err := d.Set("removed_policy_items", []interface{}{}); err != nil {
+		return nil, fmt.Errorf(errorSnapshotBackupScheduleSetting, "removed_policy_items", clusterName, err)
+	}
+
 	d.SetId(encodeStateID(map[string]string{
 		"project_id":   projectID,
 		"cluster_name": clusterName,
@@ -456,65 +525,56 @@ func resourceMongoDBAtlasCloudBackupScheduleImportState(ctx context.Context, d *
 func cloudBackupScheduleCreateOrUpdate(ctx context.Context, conn *matlas.Client, d *schema.ResourceData, projectID, clusterName string) error {
 	policy := matlas.Policy{}
 	// Get policies items
-	resp, _, err := conn.CloudProviderSnapshotBackupPolicies.Get(ctx, projectID, clusterName)
-	if err != nil {
-		log.Printf("error getting MongoDB Cloud Backup Schedule (%s): %s", clusterName, err)
+	resp, _, getErr := conn.CloudProviderSnapshotBackupPolicies.Get(ctx, projectID, clusterName)
+	if getErr != nil {
+		log.Printf("error getting MongoDB Cloud Backup Schedule (%s): %s", clusterName, getErr)
 	} else if len(resp.Policies) == 1 {
 		policy.ID = resp.Policies[0].ID
 	}
 
 	req := &matlas.CloudProviderSnapshotBackupPolicy{}
-	policyItem := matlas.PolicyItem{}
-	var policiesItem []matlas.PolicyItem
 	export := matlas.Export{}
 
 	req.CopySettings = []matlas.CopySetting{}
 	if v, ok := d.GetOk("copy_settings"); ok && len(v.([]interface{})) > 0 {
-		req.CopySettings = expandCopySettings(v.([]interface{}))
+		copySettings := v.([]interface{})
+		targets := copySettingsTargets(d.GetRawConfig(), copySettings)
+
+		var replicationSpecs []*matlas.AdvancedReplicationSpec
+		if copySettingsNeedReplicationSpecs(targets) {
+			cluster, _, err := conn.AdvancedClusters.Get(ctx, projectID, clusterName)
+			if err != nil {
+				return fmt.Errorf("error getting the replication specs of cluster (%s) for `copy_settings`: %s", clusterName, err)
+			}
+			replicationSpecs = cluster.ReplicationSpecs
+		}
+
+		var err error
+		if req.CopySettings, err = expandCopySettingsByTarget(copySettings, targets, replicationSpecs); err != nil {
+			return err
+		}
 	}
 
-	if v, ok := d.GetOk("policy_item_hourly"); ok {
-		item := v.([]interface{})
-		itemObj := item[0].(map[string]interface{})
-		policyItem.ID = policyItemID(itemObj)
-		policyItem.FrequencyType = snapshotScheduleHourly
-		policyItem.RetentionUnit = itemObj["retention_unit"].(string)
-		policyItem.FrequencyInterval = itemObj["frequency_interval"].(int)
-		policyItem.RetentionValue = itemObj["retention_value"].(int)
-		policiesItem = append(policiesItem, policyItem)
-	}
-	if v, ok := d.GetOk("policy_item_daily"); ok {
-		item := v.([]interface{})
-		itemObj := item[0].(map[string]interface{})
-		policyItem.ID = policyItemID(itemObj)
-		policyItem.FrequencyType = snapshotScheduleDaily
-		policyItem.RetentionUnit = itemObj["retention_unit"].(string)
-		policyItem.FrequencyInterval = itemObj["frequency_interval"].(int)
-		policyItem.RetentionValue = itemObj["retention_value"].(int)
-		policiesItem = append(policiesItem, policyItem)
-	}
-	if v, ok := d.GetOk("policy_item_weekly"); ok {
-		items := v.([]interface{})
-		for _, s := range items {
-			itemObj := s.(map[string]interface{})
-			policyItem.ID = policyItemID(itemObj)
-			policyItem.FrequencyType = snapshotScheduleWeekly
-			policyItem.RetentionUnit = itemObj["retention_unit"].(string)
-			policyItem.FrequencyInterval = itemObj["frequency_interval"].(int)
-			policyItem.RetentionValue = itemObj["retention_value"].(int)
-			policiesItem = append(policiesItem, policyItem)
-		}
-	}
-	if v, ok := d.GetOk("policy_item_monthly"); ok {
-		items := v.([]interface{})
-		for _, s := range items {
-			itemObj := s.(map[string]interface{})
-			policyItem.ID = policyItemID(itemObj)
-			policyItem.FrequencyType = snapshotScheduleMonthly
-			policyItem.RetentionUnit = itemObj["retention_unit"].(string)
-			policyItem.FrequencyInterval = itemObj["frequency_interval"].(int)
-			policyItem.RetentionValue = itemObj["retention_value"].(int)
-			policiesItem = append(policiesItem, policyItem)
+	policyItems := make(map[string][]interface{})
+	for attr := range backupSchedulePolicyItemFrequencies {
+		policyItems[attr] = d.Get(attr).([]interface{})
+	}
+	policiesItem := expandPolicyItems(policyItems)
+
+	if d.IsNewResource() && d.Get("adopt_existing_policy").(bool) {
+		if getErr != nil {
+			return fmt.Errorf("unable to adopt the existing policy items: %s", getErr)
+		}
+
+		var existingItems []matlas.PolicyItem
+		if resp != nil && len(resp.Policies) == 1 {
+			existingItems = resp.Policies[0].PolicyItems
+		}
+
+		var removedItems []matlas.PolicyItem
+		policiesItem, removedItems = adoptPolicyItems(existingItems, policiesItem)
+		if err := d.Set("removed_policy_items", flattenPolicyItems(removedItems)); err != nil {
+			return fmt.Errorf(errorSnapshotBackupScheduleSetting, "removed_policy_items", clusterName, err)
 		}
 	}
 
@@ -557,7 +617,7 @@ func cloudBackupScheduleCreateOrUpdate(ctx context.Context, conn *matlas.Client,
 		req.UpdateSnapshots = value
 	}
 
-	_, _, err = conn.CloudProviderSnapshotBackupPolicies.Update(context.Background(), projectID, clusterName, req)
+	_, _, err := conn.CloudProviderSnapshotBackupPolicies.Update(context.Background(), projectID, clusterName, req)
 	if err != nil {
 		return err
 	}
@@ -582,6 +642,76 @@ func flattenPolicyItem(items []matlas.PolicyItem, frequencyType string) []map[st
 	return policyItems
 }
 
+// expandPolicyItems returns the policy items of the policy_item_* attributes, in hourly, daily, weekly and monthly order.
+func expandPolicyItems(policyItems map[string][]interface{}) []matlas.PolicyItem {
+	var items []matlas.PolicyItem
+	for _, attr := range []string{"policy_item_hourly", "policy_item_daily", "policy_item_weekly", "policy_item_monthly"} {
+		for _, v := range policyItems[attr] {
+			itemObj, ok := v.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			items = append(items, matlas.PolicyItem{
+				ID:                policyItemID(itemObj),
+				FrequencyType:     backupSchedulePolicyItemFrequencies[attr],
+				RetentionUnit:     itemObj["retention_unit"].(string),
+				FrequencyInterval: itemObj["frequency_interval"].(int),
+				RetentionValue:    itemObj["retention_value"].(int),
+			})
+		}
+	}
+
+	return items
+}
+
+// adoptPolicyItems reuses the IDs of the existing policy items for the desired ones, so the snapshots taken by
+// the existing items keep their retention. A desired item takes the ID of the existing item with the same frequency
+// type and interval or, failing that, of any other unused item with the same frequency type. It returns the desired
+// items and the existing items that are left unused, which Atlas removes. If there are no desired items, nothing is
+// sent to Atlas and no item is removed.
+func adoptPolicyItems(existingItems, desiredItems []matlas.PolicyItem) (adopted, removed []matlas.PolicyItem) {
+	if len(desiredItems) == 0 {
+		return nil, nil
+	}
+
+	used := make([]bool, len(existingItems))
+	adopted = make([]matlas.PolicyItem, len(desiredItems))
+	for i := range desiredItems {
+		adopted[i] = desiredItems[i]
+		adopted[i].ID = ""
+	}
+
+	matchItems := func(sameInterval bool) {
+		for i := range adopted {
+			if adopted[i].ID != "" {
+				continue
+			}
+			for j := range existingItems {
+				if used[j] || !strings.EqualFold(existingItems[j].FrequencyType, adopted[i].FrequencyType) {
+					continue
+				}
+				if sameInterval && existingItems[j].FrequencyInterval != adopted[i].FrequencyInterval {
+					continue
+				}
+				adopted[i].ID = existingItems[j].ID
+				used[j] = true
+				break
+			}
+		}
+	}
+
+	matchItems(true)
+	matchItems(false)
+
+	for j := range existingItems {
+		if !used[j] {
+			removed = append(removed, existingItems[j])
+		}
+	}
+
+	return adopted, removed
+}
+
 func flattenExport(roles *matlas.CloudProviderSnapshotBackupPolicy) []map[string]interface{} {
 	exportList := make([]map[string]interface{}, 0)
 	emptyStruct := matlas.CloudProviderSnapshotBackupPolicy{}
@@ -608,6 +738,115 @@ func flattenCopySettings(copySettingList []matlas.CopySetting) []map[string]inte
 	return copySettings
 }
 
+// flattenCopySettingsByTarget returns the copy settings in the order of the current copy settings, so reordering by
+// Atlas doesn't cause diffs, and collapses the copy settings created for a copy setting with all_replication_specs.
+func flattenCopySettingsByTarget(copySettingList []matlas.CopySetting, currentCopySettings []interface{}, replicationSpecs []*matlas.AdvancedReplicationSpec) []map[string]interface{} {
+	zoneNames := make(map[string]string, len(replicationSpecs))
+	for _, spec := range replicationSpecs {
+		zoneNames[spec.ID] = spec.ZoneName
+	}
+
+	flatten := func(copySetting *matlas.CopySetting) map[string]interface{} {
+		return map[string]interface{}{
+			"cloud_provider":        pointy.StringValue(copySetting.CloudProvider, ""),
+			"frequencies":           copySetting.Frequencies,
+			"region_name":           pointy.StringValue(copySetting.RegionName, ""),
+			"replication_spec_id":   pointy.StringValue(copySetting.ReplicationSpecID, ""),
+			"zone_name":             zoneNames[pointy.StringValue(copySetting.ReplicationSpecID, "")],
+			"should_copy_oplogs":    pointy.BoolValue(copySetting.ShouldCopyOplogs, false),
+			"all_replication_specs": false,
+		}
+	}
+
+	// cloud_provider and region_name are also computed, so they may be empty until the first read
+	matches := func(copySetting *matlas.CopySetting, tfMap map[string]interface{}, replicationSpecID string) bool {
+		cloudProvider, regionName := tfMap["cloud_provider"].(string), tfMap["region_name"].(string)
+		return (cloudProvider == "" || pointy.StringValue(copySetting.CloudProvider, "") == cloudProvider) &&
+			(regionName == "" || pointy.StringValue(copySetting.RegionName, "") == regionName) &&
+			pointy.StringValue(copySetting.ReplicationSpecID, "") == replicationSpecID
+	}
+
+	used := make([]bool, len(copySettingList))
+	copySettings := make([]map[string]interface{}, 0, len(copySettingList))
+
+	for _, raw := range currentCopySettings {
+		tfMap, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		target := newCopySettingTarget(tfMap)
+		specs, err := resolveCopySettingTarget(target, replicationSpecs)
+		if err != nil || len(specs) == 0 {
+			continue
+		}
+
+		indexes := make([]int, 0, len(specs))
+		for _, spec := range specs {
+			for i := range copySettingList {
+				if !used[i] && matches(&copySettingList[i], tfMap, spec.ID) {
+					indexes = append(indexes, i)
+					break
+				}
+			}
+		}
+		if len(indexes) != len(specs) {
+			continue
+		}
+
+		for _, i := range indexes {
+			used[i] = true
+		}
+
+		copySetting := flatten(&copySettingList[indexes[0]])
+		if target.allReplicationSpecs {
+			copySetting["replication_spec_id"] = ""
+			copySetting["zone_name"] = ""
+			copySetting["all_replication_specs"] = true
+		}
+		copySettings = append(copySettings, copySetting)
+	}
+
+	for i := range copySettingList {
+		if !used[i] {
+			copySettings = append(copySettings, flatten(&copySettingList[i]))
+		}
+	}
+
+	return copySettings
+}
+
+// expandCopySettingsByTarget returns one copy setting for each replication spec targeted by the copy settings.
+func expandCopySettingsByTarget(tfList []interface{}, targets []*copySettingTarget, replicationSpecs []*matlas.AdvancedReplicationSpec) ([]matlas.CopySetting, error) {
+	var copySettings []matlas.CopySetting
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		copySetting := expandCopySetting(tfMap)
+		if i >= len(targets) || targets[i] == nil || targets[i].replicationSpecID != "" {
+			copySettings = append(copySettings, *copySetting)
+			continue
+		}
+
+		specs, err := resolveCopySettingTarget(targets[i], replicationSpecs)
+		if err != nil {
+			return nil, fmt.Errorf("copy_settings.%d: %s", i, err)
+		}
+
+		for _, spec := range specs {
+			specCopySetting := *copySetting
+			specCopySetting.ReplicationSpecID = pointy.String(spec.ID)
+			copySettings = append(copySettings, specCopySetting)
+		}
+	}
+
+	return copySettings, nil
+}
+
 func expandCopySetting(tfMap map[string]interface{}) *matlas.CopySetting {
 	if tfMap == nil {
 		return nil
@@ -641,6 +880,389 @@ func expandCopySettings(tfList []interface{}) []matlas.CopySetting {
 	return copySettings
 }
 
+func resourceMongoDBAtlasCloudBackupScheduleCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	return errors.Join(
+		cloudBackupScheduleCopySettingsCustomizeDiff(ctx, d, meta),
+		cloudBackupScheduleComplianceCustomizeDiff(ctx, d, meta),
+		cloudBackupScheduleAdoptionCustomizeDiff(ctx, d, meta),
+	)
+}
+
+// cloudBackupScheduleAdoptionCustomizeDiff shows in the plan the existing policy items that are removed when the
+// schedule is adopted, since they don't match any of the policy_item_* blocks.
+func cloudBackupScheduleAdoptionCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" {
+		return nil
+	}
+	if !d.Get("adopt_existing_policy").(bool) {
+		return d.SetNew("removed_policy_items", []interface{}{})
+	}
+
+	for _, attr := range []string{"project_id", "cluster_name", "policy_item_hourly", "policy_item_daily", "policy_item_weekly", "policy_item_monthly"} {
+		if !d.NewValueKnown(attr) {
+			return d.SetNewComputed("removed_policy_items")
+		}
+	}
+
+	conn := meta.(*MongoDBClient).Atlas
+	clusterName := d.Get("cluster_name").(string)
+	backupPolicy, _, err := conn.CloudProviderSnapshotBackupPolicies.Get(ctx, d.Get("project_id").(string), clusterName)
+	if err != nil {
+		// the cluster may be created in the same apply
+		log.Printf("[DEBUG] unable to get the MongoDB Cloud Backup Schedule (%s) to adopt: %s", clusterName, err)
+		return d.SetNewComputed("removed_policy_items")
+	}
+
+	var existingItems []matlas.PolicyItem
+	if len(backupPolicy.Policies) == 1 {
+		existingItems = backupPolicy.Policies[0].PolicyItems
+	}
+
+	policyItems := make(map[string][]interface{})
+	for attr := range backupSchedulePolicyItemFrequencies {
+		policyItems[attr] = d.Get(attr).([]interface{})
+	}
+
+	_, removedItems := adoptPolicyItems(existingItems, expandPolicyItems(policyItems))
+
+	return d.SetNew("removed_policy_items", flattenPolicyItems(removedItems))
+}
+
+// cloudBackupScheduleCopySettingsCustomizeDiff checks the copy settings against the topology of the cluster, so
+// invalid targets are reported during plan instead of failing the apply.
+func cloudBackupScheduleCopySettingsCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	copySettings := d.Get("copy_settings").([]interface{})
+	if len(copySettings) == 0 || !d.NewValueKnown("project_id") || !d.NewValueKnown("cluster_name") {
+		return nil
+	}
+
+	targets := copySettingsTargets(d.GetRawConfig(), copySettings)
+	if err := validateCopySettingsTargets(targets); err != nil {
+		return err
+	}
+
+	conn := meta.(*MongoDBClient).Atlas
+	cluster, _, err := conn.AdvancedClusters.Get(ctx, d.Get("project_id").(string), d.Get("cluster_name").(string))
+	if err != nil {
+		// the cluster may be created in the same apply
+		log.Printf("[DEBUG] unable to get cluster (%s), skipping copy_settings validation: %s", d.Get("cluster_name"), err)
+		return nil
+	}
+
+	return validateCopySettingsTopology(copySettings, targets, cluster.ReplicationSpecs)
+}
+
+// cloudBackupScheduleComplianceCustomizeDiff checks the schedule against the backup compliance policy of the
+// project, if any, so violations are reported during plan instead of failing the apply.
+func cloudBackupScheduleComplianceCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("project_id") {
+		return nil
+	}
+
+	for _, attr := range []string{"policy_item_hourly", "policy_item_daily", "policy_item_weekly", "policy_item_monthly", "copy_settings", "restore_window_days"} {
+		if !d.NewValueKnown(attr) {
+			return nil
+		}
+	}
+
+	conn := meta.(*MongoDBClient).Atlas
+	projectID := d.Get("project_id").(string)
+
+	compliancePolicy, err := getActiveBackupCompliancePolicy(ctx, conn, projectID)
+	if err != nil {
+		log.Printf("[WARN] unable to get the backup compliance policy of project (%s), skipping compliance checks: %s", projectID, err)
+		return nil
+	}
+	if compliancePolicy == nil {
+		return nil
+	}
+
+	policyItems := make(map[string][]interface{})
+	for attr := range backupSchedulePolicyItemFrequencies {
+		policyItems[attr] = d.Get(attr).([]interface{})
+	}
+
+	return validateBackupScheduleCompliance(compliancePolicy, policyItems, d.Get("copy_settings").([]interface{}), d.Get("restore_window_days").(int))
+}
+
+var backupSchedulePolicyItemFrequencies = map[string]string{
+	"policy_item_hourly":  snapshotScheduleHourly,
+	"policy_item_daily":   snapshotScheduleDaily,
+	"policy_item_weekly":  snapshotScheduleWeekly,
+	"policy_item_monthly": snapshotScheduleMonthly,
+}
+
+// validateBackupScheduleCompliance returns one error for every policy item, copy setting or restore window of the
+// schedule that doesn't meet the backup compliance policy. Every scheduled item of the compliance policy must be
+// matched by a schedule item with the same frequency and an equal or longer retention.
+func validateBackupScheduleCompliance(compliancePolicy *matlas.BackupCompliancePolicy, policyItems map[string][]interface{}, copySettings []interface{}, restoreWindowDays int) error {
+	if compliancePolicy == nil || (compliancePolicy.State != "" && compliancePolicy.State != "ACTIVE") {
+		return nil
+	}
+
+	var errs []error
+
+	attrs := make([]string, 0, len(backupSchedulePolicyItemFrequencies))
+	for attr := range backupSchedulePolicyItemFrequencies {
+		attrs = append(attrs, attr)
+	}
+	sort.Strings(attrs)
+
+	for i := range compliancePolicy.ScheduledPolicyItems {
+		required := &compliancePolicy.ScheduledPolicyItems[i]
+		requiredDays := policyItemRetentionDays(required.RetentionUnit, required.RetentionValue)
+		found := false
+
+		for _, attr := range attrs {
+			if backupSchedulePolicyItemFrequencies[attr] != required.FrequencyType {
+				continue
+			}
+
+			for j, raw := range policyItems[attr] {
+				item, ok := raw.(map[string]interface{})
+				if !ok || item["frequency_interval"].(int) != required.FrequencyInterval {
+					continue
+				}
+
+				found = true
+				retentionUnit := item["retention_unit"].(string)
+				retentionValue := item["retention_value"].(int)
+				if policyItemRetentionDays(retentionUnit, retentionValue) < requiredDays {
+					errs = append(errs, fmt.Errorf("%s.%d: retention of %d %s is below the %d %s required by the backup compliance policy",
+						attr, j, retentionValue, retentionUnit, required.RetentionValue, required.RetentionUnit))
+				}
+			}
+		}
+
+		if !found {
+			errs = append(errs, fmt.Errorf("policy_item_%s: an item with frequency_interval %d and a retention of at least %d %s is required by the backup compliance policy",
+				required.FrequencyType, required.FrequencyInterval, required.RetentionValue, required.RetentionUnit))
+		}
+	}
+
+	if compliancePolicy.CopyProtectionEnabled != nil && *compliancePolicy.CopyProtectionEnabled {
+		for i, raw := range copySettings {
+			copySetting, ok := raw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			frequencies := make(map[string]bool)
+			if v, ok := copySetting["frequencies"].(*schema.Set); ok {
+				for _, frequency := range v.List() {
+					frequencies[strings.ToLower(frequency.(string))] = true
+				}
+			}
+
+			for _, required := range compliancePolicy.ScheduledPolicyItems {
+				if !frequencies[required.FrequencyType] {
+					errs = append(errs, fmt.Errorf("copy_settings.%d: frequencies must include %s, which is required by the backup compliance policy",
+						i, strings.ToUpper(required.FrequencyType)))
+					frequencies[required.FrequencyType] = true
+				}
+			}
+
+			if compliancePolicy.PitEnabled != nil && *compliancePolicy.PitEnabled && !copySetting["should_copy_oplogs"].(bool) {
+				errs = append(errs, fmt.Errorf("copy_settings.%d: should_copy_oplogs must be true because the backup compliance policy requires continuous cloud backups", i))
+			}
+		}
+	}
+
+	if compliancePolicy.RestoreWindowDays != nil && restoreWindowDays > 0 && int64(restoreWindowDays) < *compliancePolicy.RestoreWindowDays {
+		errs = append(errs, fmt.Errorf("restore_window_days: %d is below the %d days required by the backup compliance policy",
+			restoreWindowDays, *compliancePolicy.RestoreWindowDays))
+	}
+
+	return errors.Join(errs...)
+}
+
+// policyItemRetentionDays returns an approximation of the retention in days so retentions expressed in different units can be compared.
+func policyItemRetentionDays(retentionUnit string, retentionValue int) int {
+	switch strings.ToLower(retentionUnit) {
+	case "weeks":
+		return retentionValue * 7
+	case "months":
+		return retentionValue * 31
+	case "years":
+		return retentionValue * 365
+	default:
+		return retentionValue
+	}
+}
+
+// copySettingTarget identifies the replication specs a copy setting applies to, as written in the configuration.
+// A nil target means the configuration isn't known yet.
+type copySettingTarget struct {
+	replicationSpecID   string
+	zoneName            string
+	allReplicationSpecs bool
+}
+
+// copySettingsTargets reads the targets from the raw configuration, because replication_spec_id and zone_name are
+// also computed and the planned values may come from the state. If the configuration isn't available, the
+// planned values are used.
+func copySettingsTargets(rawConfig cty.Value, copySettings []interface{}) []*copySettingTarget {
+	targets := make([]*copySettingTarget, len(copySettings))
+
+	var rawCopySettings []cty.Value
+	if rawConfig.IsKnown() && !rawConfig.IsNull() && rawConfig.Type().IsObjectType() && rawConfig.Type().HasAttribute("copy_settings") {
+		if v := rawConfig.GetAttr("copy_settings"); v.IsKnown() && !v.IsNull() && v.CanIterateElements() {
+			rawCopySettings = v.AsValueSlice()
+		}
+	}
+
+	for i, raw := range copySettings {
+		tfMap, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if i >= len(rawCopySettings) {
+			targets[i] = newCopySettingTarget(tfMap)
+			continue
+		}
+
+		target := &copySettingTarget{}
+		known := true
+		for attr, value := range map[string]*string{"replication_spec_id": &target.replicationSpecID, "zone_name": &target.zoneName} {
+			v := rawCopySettings[i].GetAttr(attr)
+			known = known && v.IsKnown()
+			if v.IsKnown() && !v.IsNull() {
+				*value = v.AsString()
+			}
+		}
+		v := rawCopySettings[i].GetAttr("all_replication_specs")
+		known = known && v.IsKnown()
+		if v.IsKnown() && !v.IsNull() {
+			target.allReplicationSpecs = v.True()
+		}
+
+		if known {
+			targets[i] = target
+		}
+	}
+
+	return targets
+}
+
+// newCopySettingTarget returns the target of a copy setting from planned or state values, where replication_spec_id
+// and zone_name are both set once read. all_replication_specs takes precedence over zone_name, and zone_name over
+// replication_spec_id, as they identify the replication specs more broadly.
+func newCopySettingTarget(tfMap map[string]interface{}) *copySettingTarget {
+	target := &copySettingTarget{
+		replicationSpecID:   tfMap["replication_spec_id"].(string),
+		zoneName:            tfMap["zone_name"].(string),
+		allReplicationSpecs: tfMap["all_replication_specs"].(bool),
+	}
+	if target.allReplicationSpecs {
+		target.replicationSpecID, target.zoneName = "", ""
+	} else if target.zoneName != "" {
+		target.replicationSpecID = ""
+	}
+	return target
+}
+
+func validateCopySettingsTargets(targets []*copySettingTarget) error {
+	var errs []error
+	for i, target := range targets {
+		if target == nil {
+			continue
+		}
+
+		count := 0
+		for _, isSet := range []bool{target.replicationSpecID != "", target.zoneName != "", target.allReplicationSpecs} {
+			if isSet {
+				count++
+			}
+		}
+		if count != 1 {
+			errs = append(errs, fmt.Errorf("copy_settings.%d: exactly one of replication_spec_id, zone_name or all_replication_specs must be set", i))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// resolveCopySettingTarget returns the replication specs of the cluster a copy setting applies to.
+func resolveCopySettingTarget(target *copySettingTarget, replicationSpecs []*matlas.AdvancedReplicationSpec) ([]*matlas.AdvancedReplicationSpec, error) {
+	if target.allReplicationSpecs {
+		return replicationSpecs, nil
+	}
+
+	zoneNames := make([]string, 0, len(replicationSpecs))
+	for _, spec := range replicationSpecs {
+		if (target.replicationSpecID != "" && spec.ID == target.replicationSpecID) || (target.replicationSpecID == "" && spec.ZoneName == target.zoneName) {
+			return []*matlas.AdvancedReplicationSpec{spec}, nil
+		}
+		zoneNames = append(zoneNames, spec.ZoneName)
+	}
+
+	if target.replicationSpecID != "" {
+		return nil, fmt.Errorf("replication_spec_id %s doesn't exist in the cluster", target.replicationSpecID)
+	}
+	return nil, fmt.Errorf("zone_name %q doesn't exist in the cluster, available zones: %s", target.zoneName, strings.Join(zoneNames, ", "))
+}
+
+// validateCopySettingsTopology checks that every copy setting targets existing replication specs, uses the same cloud
+// provider as the replication spec and copies the snapshots to a region the replication spec isn't deployed to.
+func validateCopySettingsTopology(copySettings []interface{}, targets []*copySettingTarget, replicationSpecs []*matlas.AdvancedReplicationSpec) error {
+	var errs []error
+
+	for i, raw := range copySettings {
+		tfMap, ok := raw.(map[string]interface{})
+		if !ok || i >= len(targets) || targets[i] == nil {
+			continue
+		}
+
+		specs, err := resolveCopySettingTarget(targets[i], replicationSpecs)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("copy_settings.%d: %s", i, err))
+			continue
+		}
+
+		cloudProvider := tfMap["cloud_provider"].(string)
+		regionName := tfMap["region_name"].(string)
+		for _, spec := range specs {
+			providers := make([]string, 0, len(spec.RegionConfigs))
+			for _, regionConfig := range spec.RegionConfigs {
+				provider := regionConfig.ProviderName
+				if provider == "TENANT" {
+					provider = regionConfig.BackingProviderName
+				}
+				providers = append(providers, provider)
+
+				if regionName != "" && regionConfig.RegionName == regionName {
+					errs = append(errs, fmt.Errorf("copy_settings.%d: region_name %s must differ from the regions of zone %q", i, regionName, spec.ZoneName))
+				}
+			}
+
+			if cloudProvider != "" && !stringInSlice(providers, cloudProvider) {
+				errs = append(errs, fmt.Errorf("copy_settings.%d: cloud_provider %s doesn't match the cloud provider of zone %q (%s)",
+					i, cloudProvider, spec.ZoneName, strings.Join(providers, ", ")))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func stringInSlice(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
+
+func copySettingsNeedReplicationSpecs(targets []*copySettingTarget) bool {
+	for _, target := range targets {
+		if target != nil && target.replicationSpecID == "" {
+			return true
+		}
+	}
+	return false
+}
+
 func policyItemID(policyState map[string]interface{}) string {
 	// if the policyItem has the ID field, this is the update operation
 	// we return the ID that was stored in the TF state
@@ -4,8 +4,11 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"regexp"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -239,6 +242,52 @@ func TestAccBackupRSCloudBackupSchedule_onepolicy(t *testing.T) {
 	})
 }
 
+func TestAccBackupRSCloudBackupSchedule_adoptExistingPolicy(t *testing.T) {
+	var (
+		resourceName = "mongodbatlas_cloud_backup_schedule.schedule_test"
+		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		projectName  = acctest.RandomWithPrefix("test-acc")
+		clusterName  = fmt.Sprintf("test-acc-%s", acctest.RandString(10))
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasCloudBackupScheduleAdoptConfig(orgID, projectName, clusterName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mongodbatlas_cluster.my_cluster", "cloud_backup", "true"),
+				),
+			},
+			{
+				// the default policy of the cluster has hourly, daily, weekly and monthly items
+				Config: testAccMongoDBAtlasCloudBackupScheduleAdoptConfig(orgID, projectName, clusterName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasCloudBackupScheduleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "adopt_existing_policy", "true"),
+					resource.TestCheckResourceAttr(resourceName, "policy_item_hourly.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "policy_item_daily.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "policy_item_daily.0.id"),
+					resource.TestCheckResourceAttr(resourceName, "policy_item_daily.0.retention_value", "14"),
+					resource.TestCheckResourceAttr(resourceName, "policy_item_weekly.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "policy_item_weekly.0.id"),
+					resource.TestCheckResourceAttr(resourceName, "policy_item_monthly.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "removed_policy_items.#", "2"),
+				),
+			},
+			{
+				// destroying an adopted schedule leaves the policy in Atlas
+				Config: testAccMongoDBAtlasCloudBackupScheduleAdoptConfig(orgID, projectName, clusterName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasCloudBackupSchedulePolicyItemCount(projectName, clusterName, 2),
+				),
+			},
+		},
+	})
+}
+
 func TestAccBackupRSCloudBackupSchedule_copySettings(t *testing.T) {
 	var (
 		resourceName = "mongodbatlas_cloud_backup_schedule.schedule_test"
@@ -285,6 +334,39 @@ func TestAccBackupRSCloudBackupSchedule_copySettings(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "copy_settings.0.should_copy_oplogs", "true"),
 				),
 			},
+			{
+				Config: testAccMongoDBAtlasCloudBackupScheduleCopySettingsTargetConfig(orgID, projectName, clusterName, &matlas.CloudProviderSnapshotBackupPolicy{
+					ReferenceHourOfDay:    pointy.Int64(3),
+					ReferenceMinuteOfHour: pointy.Int64(45),
+					RestoreWindowDays:     pointy.Int64(1),
+				}, `zone_name = "ZoneName managed by Terraform"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasCloudBackupScheduleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "copy_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "copy_settings.0.zone_name", "ZoneName managed by Terraform"),
+					resource.TestCheckResourceAttrPair(resourceName, "copy_settings.0.replication_spec_id", "mongodbatlas_cluster.my_cluster", "replication_specs.0.id"),
+				),
+			},
+			{
+				Config: testAccMongoDBAtlasCloudBackupScheduleCopySettingsTargetConfig(orgID, projectName, clusterName, &matlas.CloudProviderSnapshotBackupPolicy{
+					ReferenceHourOfDay:    pointy.Int64(3),
+					ReferenceMinuteOfHour: pointy.Int64(45),
+					RestoreWindowDays:     pointy.Int64(1),
+				}, "all_replication_specs = true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasCloudBackupScheduleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "copy_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "copy_settings.0.all_replication_specs", "true"),
+				),
+			},
+			{
+				Config: testAccMongoDBAtlasCloudBackupScheduleCopySettingsTargetConfig(orgID, projectName, clusterName, &matlas.CloudProviderSnapshotBackupPolicy{
+					ReferenceHourOfDay:    pointy.Int64(3),
+					ReferenceMinuteOfHour: pointy.Int64(45),
+					RestoreWindowDays:     pointy.Int64(1),
+				}, `zone_name = "Unknown zone"`),
+				ExpectError: regexp.MustCompile(`zone_name "Unknown zone" doesn't exist in the cluster`),
+			},
 		},
 	})
 }
@@ -391,6 +473,279 @@ func TestAccBackupRSCloudBackupSchedule_azure(t *testing.T) {
 	})
 }
 
+func TestValidateBackupScheduleCompliance(t *testing.T) {
+	compliancePolicy := &matlas.BackupCompliancePolicy{
+		CopyProtectionEnabled: pointy.Bool(true),
+		RestoreWindowDays:     pointy.Int64(7),
+		State:                 "ACTIVE",
+		ScheduledPolicyItems: []matlas.ScheduledPolicyItem{
+			{FrequencyType: snapshotScheduleDaily, FrequencyInterval: 1, RetentionUnit: "days", RetentionValue: 7},
+			{FrequencyType: snapshotScheduleWeekly, FrequencyInterval: 6, RetentionUnit: "weeks", RetentionValue: 4},
+		},
+	}
+	policyItem := func(interval int, unit string, value int) map[string]interface{} {
+		return map[string]interface{}{"frequency_interval": interval, "retention_unit": unit, "retention_value": value}
+	}
+	copySetting := func(frequencies ...interface{}) map[string]interface{} {
+		return map[string]interface{}{"frequencies": schema.NewSet(schema.HashString, frequencies), "should_copy_oplogs": false}
+	}
+
+	testCases := []struct {
+		name              string
+		policyItems       map[string][]interface{}
+		copySettings      []interface{}
+		restoreWindowDays int
+		expectedErrors    []string
+	}{
+		{
+			name: "compliant schedule",
+			policyItems: map[string][]interface{}{
+				"policy_item_daily":  {policyItem(1, "days", 7)},
+				"policy_item_weekly": {policyItem(6, "months", 1)},
+			},
+			copySettings:      []interface{}{copySetting("DAILY", "WEEKLY")},
+			restoreWindowDays: 7,
+		},
+		{
+			name: "retention below minimum",
+			policyItems: map[string][]interface{}{
+				"policy_item_daily":  {policyItem(1, "days", 3)},
+				"policy_item_weekly": {policyItem(6, "weeks", 4)},
+			},
+			expectedErrors: []string{"policy_item_daily.0: retention of 3 days"},
+		},
+		{
+			name: "missing policy item",
+			policyItems: map[string][]interface{}{
+				"policy_item_daily": {policyItem(1, "days", 7)},
+			},
+			expectedErrors: []string{"policy_item_weekly: an item with frequency_interval 6"},
+		},
+		{
+			name: "copy settings and restore window",
+			policyItems: map[string][]interface{}{
+				"policy_item_daily":  {policyItem(1, "days", 7)},
+				"policy_item_weekly": {policyItem(6, "weeks", 4)},
+			},
+			copySettings:      []interface{}{copySetting("DAILY")},
+			restoreWindowDays: 2,
+			expectedErrors:    []string{"copy_settings.0: frequencies must include WEEKLY", "restore_window_days: 2"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateBackupScheduleCompliance(compliancePolicy, tc.policyItems, tc.copySettings, tc.restoreWindowDays)
+			if len(tc.expectedErrors) == 0 {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("expected errors %v, got none", tc.expectedErrors)
+			}
+			for _, expected := range tc.expectedErrors {
+				if !strings.Contains(err.Error(), expected) {
+					t.Errorf("expected error containing %q, got: %s", expected, err)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateCopySettingsTopology(t *testing.T) {
+	replicationSpecs := []*matlas.AdvancedReplicationSpec{
+		{ID: "spec-1", ZoneName: "Zone 1", RegionConfigs: []*matlas.AdvancedRegionConfig{{ProviderName: "AWS", RegionName: "US_EAST_1"}}},
+		{ID: "spec-2", ZoneName: "Zone 2", RegionConfigs: []*matlas.AdvancedRegionConfig{{ProviderName: "AWS", RegionName: "EU_WEST_1"}}},
+	}
+	copySetting := func(cloudProvider, regionName string) map[string]interface{} {
+		return map[string]interface{}{"cloud_provider": cloudProvider, "region_name": regionName}
+	}
+
+	testCases := []struct {
+		name           string
+		copySetting    map[string]interface{}
+		target         *copySettingTarget
+		expectedErrors []string
+	}{
+		{name: "zone name", copySetting: copySetting("AWS", "US_WEST_2"), target: &copySettingTarget{zoneName: "Zone 2"}},
+		{name: "all replication specs", copySetting: copySetting("AWS", "US_WEST_2"), target: &copySettingTarget{allReplicationSpecs: true}},
+		{name: "replication spec id", copySetting: copySetting("AWS", "EU_WEST_1"), target: &copySettingTarget{replicationSpecID: "spec-1"}},
+		{
+			name:           "unknown zone",
+			copySetting:    copySetting("AWS", "US_WEST_2"),
+			target:         &copySettingTarget{zoneName: "Zone 3"},
+			expectedErrors: []string{`copy_settings.0: zone_name "Zone 3" doesn't exist in the cluster, available zones: Zone 1, Zone 2`},
+		},
+		{
+			name:           "same region as source",
+			copySetting:    copySetting("AWS", "EU_WEST_1"),
+			target:         &copySettingTarget{allReplicationSpecs: true},
+			expectedErrors: []string{`copy_settings.0: region_name EU_WEST_1 must differ from the regions of zone "Zone 2"`},
+		},
+		{
+			name:           "different cloud provider",
+			copySetting:    copySetting("GCP", "CENTRAL_US"),
+			target:         &copySettingTarget{zoneName: "Zone 1"},
+			expectedErrors: []string{`copy_settings.0: cloud_provider GCP doesn't match the cloud provider of zone "Zone 1" (AWS)`},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateCopySettingsTopology([]interface{}{tc.copySetting}, []*copySettingTarget{tc.target}, replicationSpecs)
+			if len(tc.expectedErrors) == 0 {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("expected errors %v, got none", tc.expectedErrors)
+			}
+			for _, expected := range tc.expectedErrors {
+				if !strings.Contains(err.Error(), expected) {
+					t.Errorf("expected error containing %q, got: %s", expected, err)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateCopySettingsTargets(t *testing.T) {
+	err := validateCopySettingsTargets([]*copySettingTarget{
+		{zoneName: "Zone 1"},
+		nil,
+		{zoneName: "Zone 1", allReplicationSpecs: true},
+		{},
+	})
+	if err == nil {
+		t.Fatal("expected errors, got none")
+	}
+	for _, expected := range []string{"copy_settings.2: exactly one", "copy_settings.3: exactly one"} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf("expected error containing %q, got: %s", expected, err)
+		}
+	}
+	if strings.Contains(err.Error(), "copy_settings.0") || strings.Contains(err.Error(), "copy_settings.1") {
+		t.Errorf("unexpected error for valid or unknown targets: %s", err)
+	}
+}
+
+func TestFlattenCopySettingsByTarget(t *testing.T) {
+	replicationSpecs := []*matlas.AdvancedReplicationSpec{{ID: "spec-1", ZoneName: "Zone 1"}, {ID: "spec-2", ZoneName: "Zone 2"}}
+	apiCopySetting := func(replicationSpecID, regionName string) matlas.CopySetting {
+		return matlas.CopySetting{
+			CloudProvider:     pointy.String("AWS"),
+			RegionName:        pointy.String(regionName),
+			ReplicationSpecID: pointy.String(replicationSpecID),
+			ShouldCopyOplogs:  pointy.Bool(false),
+			Frequencies:       []string{"DAILY"},
+		}
+	}
+	currentCopySetting := func(zoneName, regionName string, allReplicationSpecs bool) map[string]interface{} {
+		return map[string]interface{}{
+			"cloud_provider":        "AWS",
+			"region_name":           regionName,
+			"replication_spec_id":   "",
+			"zone_name":             zoneName,
+			"all_replication_specs": allReplicationSpecs,
+		}
+	}
+
+	copySettings := flattenCopySettingsByTarget(
+		[]matlas.CopySetting{apiCopySetting("spec-2", "US_WEST_2"), apiCopySetting("spec-1", "US_WEST_2"), apiCopySetting("spec-2", "US_EAST_2")},
+		[]interface{}{currentCopySetting("Zone 2", "US_EAST_2", false), currentCopySetting("", "US_WEST_2", true)},
+		replicationSpecs,
+	)
+
+	if len(copySettings) != 2 {
+		t.Fatalf("expected 2 copy settings, got %d: %v", len(copySettings), copySettings)
+	}
+	if copySettings[0]["zone_name"] != "Zone 2" || copySettings[0]["region_name"] != "US_EAST_2" || copySettings[0]["replication_spec_id"] != "spec-2" {
+		t.Errorf("unexpected first copy setting: %v", copySettings[0])
+	}
+	if copySettings[1]["all_replication_specs"] != true || copySettings[1]["region_name"] != "US_WEST_2" || copySettings[1]["replication_spec_id"] != "" {
+		t.Errorf("unexpected second copy setting: %v", copySettings[1])
+	}
+}
+
+func TestAdoptPolicyItems(t *testing.T) {
+	existingItems := []matlas.PolicyItem{
+		{ID: "hourly-1", FrequencyType: snapshotScheduleHourly, FrequencyInterval: 6, RetentionUnit: "days", RetentionValue: 7},
+		{ID: "daily-1", FrequencyType: snapshotScheduleDaily, FrequencyInterval: 1, RetentionUnit: "days", RetentionValue: 7},
+		{ID: "weekly-1", FrequencyType: snapshotScheduleWeekly, FrequencyInterval: 6, RetentionUnit: "weeks", RetentionValue: 4},
+		{ID: "monthly-1", FrequencyType: snapshotScheduleMonthly, FrequencyInterval: 40, RetentionUnit: "months", RetentionValue: 12},
+	}
+
+	testCases := []struct {
+		name            string
+		desiredItems    []matlas.PolicyItem
+		expectedIDs     []string
+		expectedRemoved []string
+	}{
+		{
+			name: "same items",
+			desiredItems: []matlas.PolicyItem{
+				{FrequencyType: snapshotScheduleHourly, FrequencyInterval: 6, RetentionUnit: "days", RetentionValue: 7},
+				{FrequencyType: snapshotScheduleDaily, FrequencyInterval: 1, RetentionUnit: "days", RetentionValue: 7},
+				{FrequencyType: snapshotScheduleWeekly, FrequencyInterval: 6, RetentionUnit: "weeks", RetentionValue: 4},
+				{FrequencyType: snapshotScheduleMonthly, FrequencyInterval: 40, RetentionUnit: "months", RetentionValue: 12},
+			},
+			expectedIDs: []string{"hourly-1", "daily-1", "weekly-1", "monthly-1"},
+		},
+		{
+			name: "changed retention and interval",
+			desiredItems: []matlas.PolicyItem{
+				{FrequencyType: snapshotScheduleDaily, FrequencyInterval: 1, RetentionUnit: "days", RetentionValue: 14},
+				{FrequencyType: snapshotScheduleWeekly, FrequencyInterval: 1, RetentionUnit: "weeks", RetentionValue: 4},
+			},
+			expectedIDs:     []string{"daily-1", "weekly-1"},
+			expectedRemoved: []string{"hourly-1", "monthly-1"},
+		},
+		{
+			name: "additional weekly items",
+			desiredItems: []matlas.PolicyItem{
+				{FrequencyType: snapshotScheduleWeekly, FrequencyInterval: 1, RetentionUnit: "weeks", RetentionValue: 4},
+				{FrequencyType: snapshotScheduleWeekly, FrequencyInterval: 6, RetentionUnit: "weeks", RetentionValue: 8},
+			},
+			expectedIDs:     []string{"", "weekly-1"},
+			expectedRemoved: []string{"hourly-1", "daily-1", "monthly-1"},
+		},
+		{
+			name: "no desired items",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			adopted, removed := adoptPolicyItems(existingItems, tc.desiredItems)
+
+			if len(adopted) != len(tc.expectedIDs) {
+				t.Fatalf("expected %d adopted items, got %d", len(tc.expectedIDs), len(adopted))
+			}
+			for i := range adopted {
+				if adopted[i].ID != tc.expectedIDs[i] {
+					t.Errorf("expected adopted item %d to have ID %q, got %q", i, tc.expectedIDs[i], adopted[i].ID)
+				}
+				if adopted[i].RetentionValue != tc.desiredItems[i].RetentionValue {
+					t.Errorf("expected adopted item %d to keep the desired retention, got %d", i, adopted[i].RetentionValue)
+				}
+			}
+
+			if len(removed) != len(tc.expectedRemoved) {
+				t.Fatalf("expected %d removed items, got %d", len(tc.expectedRemoved), len(removed))
+			}
+			for i := range removed {
+				if removed[i].ID != tc.expectedRemoved[i] {
+					t.Errorf("expected removed item %d to have ID %q, got %q", i, tc.expectedRemoved[i], removed[i].ID)
+				}
+			}
+		})
+	}
+}
+
 func testAccCheckMongoDBAtlasCloudBackupScheduleExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := testAccProviderSdkV2.Meta().(*MongoDBClient).Atlas
@@ -442,6 +797,27 @@ func testAccCheckMongoDBAtlasCloudBackupScheduleDestroy(s *terraform.State) erro
 	return nil
 }
 
+func testAccCheckMongoDBAtlasCloudBackupSchedulePolicyItemCount(projectName, clusterName string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProviderSdkV2.Meta().(*MongoDBClient).Atlas
+
+		rs, ok := s.RootModule().Resources["mongodbatlas_cluster.my_cluster"]
+		if !ok {
+			return fmt.Errorf("not found: cluster %s of project %s", clusterName, projectName)
+		}
+
+		backupPolicy, _, err := conn.CloudProviderSnapshotBackupPolicies.Get(context.Background(), rs.Primary.Attributes["project_id"], clusterName)
+		if err != nil {
+			return fmt.Errorf(errorSnapshotBackupScheduleRead, clusterName, err)
+		}
+		if len(backupPolicy.Policies) != 1 || len(backupPolicy.Policies[0].PolicyItems) != count {
+			return fmt.Errorf("expected %d policy items in the Cloud Backup Schedule (%s), got %v", count, clusterName, backupPolicy.Policies)
+		}
+
+		return nil
+	}
+}
+
 func testAccMongoDBAtlasCloudBackupScheduleConfigNoPolicies(orgID, projectName, clusterName string, p *matlas.CloudProviderSnapshotBackupPolicy) string {
 	return fmt.Sprintf(`
 		resource "mongodbatlas_project" "backup_project" {
@@ -530,6 +906,11 @@ func testAccMongoDBAtlasCloudBackupScheduleDefaultConfig(orgID, projectName, clu
 }
 
 func testAccMongoDBAtlasCloudBackupScheduleCopySettingsConfig(orgID, projectName, clusterName string, p *matlas.CloudProviderSnapshotBackupPolicy) string {
+	return testAccMongoDBAtlasCloudBackupScheduleCopySettingsTargetConfig(orgID, projectName, clusterName, p,
+		"replication_spec_id = mongodbatlas_cluster.my_cluster.replication_specs.*.id[0]")
+}
+
+func testAccMongoDBAtlasCloudBackupScheduleCopySettingsTargetConfig(orgID, projectName, clusterName string, p *matlas.CloudProviderSnapshotBackupPolicy, copyTarget string) string {
 	return fmt.Sprintf(`
 		resource "mongodbatlas_project" "backup_project" {
 			name   = %[2]q
@@ -593,11 +974,52 @@ func testAccMongoDBAtlasCloudBackupScheduleCopySettingsConfig(orgID, projectName
 							"MONTHLY",
 							"ON_DEMAND"]
 				region_name = "US_EAST_1"
-				replication_spec_id = mongodbatlas_cluster.my_cluster.replication_specs.*.id[0]
+				%[7]s
 				should_copy_oplogs = true
 			  }
 		}
-	`, orgID, projectName, clusterName, *p.ReferenceHourOfDay, *p.ReferenceMinuteOfHour, *p.RestoreWindowDays)
+	`, orgID, projectName, clusterName, *p.ReferenceHourOfDay, *p.ReferenceMinuteOfHour, *p.RestoreWindowDays, copyTarget)
+}
+
+func testAccMongoDBAtlasCloudBackupScheduleAdoptConfig(orgID, projectName, clusterName string, withSchedule bool) string {
+	config := fmt.Sprintf(`
+		resource "mongodbatlas_project" "backup_project" {
+			name   = %[2]q
+			org_id = %[1]q
+		}
+		resource "mongodbatlas_cluster" "my_cluster" {
+			project_id   = mongodbatlas_project.backup_project.id
+			name         = %[3]q
+
+			// Provider Settings "block"
+			provider_name               = "AWS"
+			provider_region_name        = "EU_CENTRAL_1"
+			provider_instance_size_name = "M10"
+			cloud_backup     = true //enable cloud provider snapshots
+		}
+	`, orgID, projectName, clusterName)
+	if !withSchedule {
+		return config
+	}
+
+	return config + `
+		resource "mongodbatlas_cloud_backup_schedule" "schedule_test" {
+			project_id            = mongodbatlas_cluster.my_cluster.project_id
+			cluster_name          = mongodbatlas_cluster.my_cluster.name
+			adopt_existing_policy = true
+
+			policy_item_daily {
+				frequency_interval = 1
+				retention_unit     = "days"
+				retention_value    = 14
+			}
+			policy_item_weekly {
+				frequency_interval = 6
+				retention_unit     = "weeks"
+				retention_value    = 4
+			}
+		}
+	`
 }
 
 func testAccMongoDBAtlasCloudBackupScheduleOnePolicyConfig(orgID, projectName, clusterName string, p *matlas.CloudProviderSnapshotBackupPolicy) string {
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/spf13/cast"
+	"go.mongodb.org/atlas-sdk/v20230201006/admin"
 	matlas "go.mongodb.org/atlas/mongodbatlas"
 )
 
@@ -22,7 +23,9 @@ func resourceMongoDBAtlasCloudBackupSnapshot() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceMongoDBAtlasCloudBackupSnapshotCreate,
 		ReadContext:   resourceMongoDBAtlasCloudBackupSnapshotRead,
+		UpdateContext: resourceMongoDBAtlasCloudBackupSnapshotUpdate,
 		DeleteContext: resourceMongoDBAtlasCloudBackupSnapshotDelete,
+		CustomizeDiff: resourceMongoDBAtlasCloudBackupSnapshotCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceMongoDBAtlasCloudBackupSnapshotImportState,
 		},
@@ -45,9 +48,17 @@ func resourceMongoDBAtlasCloudBackupSnapshot() *schema.Resource {
 			"retention_in_days": {
 				Type:         schema.TypeInt,
 				Required:     true,
-				ForceNew:     true,
 				ValidateFunc: validation.IntAtLeast(1),
 			},
+			"retain_on_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"lock_until": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
 			"created_at": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -196,6 +207,18 @@ func resourceMongoDBAtlasCloudBackupSnapshotRead(ctx context.Context, d *schema.
 		return diag.FromErr(fmt.Errorf("error setting `snapshot_ids` for snapshot (%s): %s", ids["snapshot_id"], err))
 	}
 
+	// CustomizeDiff isn't called when planning a destroy, so the refresh warns that the destroy will fail instead
+	if d.Get("retain_on_destroy").(bool) {
+		return nil
+	}
+	if err := validateSnapshotDeletionCompliance(ctx, conn, ids["project_id"], ids["snapshot_id"]); err != nil {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  "Snapshot can't be destroyed",
+			Detail:   err.Error(),
+		}}
+	}
+
 	return nil
 }
 
@@ -228,33 +251,65 @@ func resourceMongoDBAtlasCloudBackupSnapshotCreate(ctx context.Context, d *schem
 		return diag.FromErr(err)
 	}
 
+	snapshot, err := takeCloudBackupSnapshot(ctx, conn, requestParameters, snapshotReq, 1*time.Hour)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"project_id":   d.Get("project_id").(string),
+		"cluster_name": d.Get("cluster_name").(string),
+		"snapshot_id":  snapshot.ID,
+	}))
+
+	return resourceMongoDBAtlasCloudBackupSnapshotRead(ctx, d, meta)
+}
+
+// takeCloudBackupSnapshot takes an on-demand snapshot of the cluster and waits up to timeout until it's completed.
+func takeCloudBackupSnapshot(ctx context.Context, conn *matlas.Client, requestParameters *matlas.SnapshotReqPathParameters, snapshotReq *matlas.CloudProviderSnapshot, timeout time.Duration) (*matlas.CloudProviderSnapshot, error) {
 	snapshot, _, err := conn.CloudProviderSnapshots.Create(ctx, requestParameters, snapshotReq)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error taking a snapshot: %s", err))
+		return nil, fmt.Errorf("error taking a snapshot: %s", err)
 	}
 
-	requestParameters.SnapshotID = snapshot.ID
+	snapshotParameters := &matlas.SnapshotReqPathParameters{
+		GroupID:     requestParameters.GroupID,
+		ClusterName: requestParameters.ClusterName,
+		SnapshotID:  snapshot.ID,
+	}
 
-	stateConf = &retry.StateChangeConf{
+	stateConf := &retry.StateChangeConf{
 		Pending:    []string{"queued", "inProgress"},
 		Target:     []string{"completed", "failed"},
-		Refresh:    resourceCloudBackupSnapshotRefreshFunc(ctx, requestParameters, conn),
-		Timeout:    1 * time.Hour,
+		Refresh:    resourceCloudBackupSnapshotRefreshFunc(ctx, snapshotParameters, conn),
+		Timeout:    timeout,
 		MinTimeout: 60 * time.Second,
 		Delay:      1 * time.Minute,
 	}
 
 	// Wait, catching any errors
-	_, err = stateConf.WaitForStateContext(ctx)
-	if err != nil {
-		return diag.FromErr(err)
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return nil, err
 	}
 
-	d.SetId(encodeStateID(map[string]string{
-		"project_id":   d.Get("project_id").(string),
-		"cluster_name": d.Get("cluster_name").(string),
-		"snapshot_id":  snapshot.ID,
-	}))
+	return snapshot, nil
+}
+
+func resourceMongoDBAtlasCloudBackupSnapshotUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connV2 := meta.(*MongoDBClient).AtlasV2
+	ids := decodeStateID(d.Id())
+
+	if d.HasChange("retention_in_days") {
+		retention := &admin.BackupSnapshotRetention{
+			RetentionUnit:  "days",
+			RetentionValue: d.Get("retention_in_days").(int),
+		}
+
+		_, _, err := connV2.CloudBackupsApi.UpdateSnapshotRetention(ctx, ids["project_id"], ids["cluster_name"], ids["snapshot_id"], retention).Execute()
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error updating the retention of snapshot (%s): %s", ids["snapshot_id"], err))
+		}
+	}
 
 	return resourceMongoDBAtlasCloudBackupSnapshotRead(ctx, d, meta)
 }
@@ -264,6 +319,21 @@ func resourceMongoDBAtlasCloudBackupSnapshotDelete(ctx context.Context, d *schem
 	conn := meta.(*MongoDBClient).Atlas
 	ids := decodeStateID(d.Id())
 
+	if lockUntil := d.Get("lock_until").(string); lockUntil != "" {
+		if until, err := time.Parse(time.RFC3339, lockUntil); err == nil && time.Now().Before(until) {
+			return diag.Errorf("snapshot (%s) is locked until %s and can't be deleted, remove `lock_until` or wait until it's expired", ids["snapshot_id"], lockUntil)
+		}
+	}
+
+	if d.Get("retain_on_destroy").(bool) {
+		log.Printf("[DEBUG] removing snapshot (%s) from the state, Atlas deletes it when it expires", ids["snapshot_id"])
+		return nil
+	}
+
+	if err := validateSnapshotDeletionCompliance(ctx, conn, ids["project_id"], ids["snapshot_id"]); err != nil {
+		return diag.FromErr(err)
+	}
+
 	requestParameters := &matlas.SnapshotReqPathParameters{
 		SnapshotID:  ids["snapshot_id"],
 		GroupID:     ids["project_id"],
@@ -278,6 +348,94 @@ func resourceMongoDBAtlasCloudBackupSnapshotDelete(ctx context.Context, d *schem
 	return nil
 }
 
+// resourceMongoDBAtlasCloudBackupSnapshotCustomizeDiff checks that the retention keeps the snapshot at least
+// until lock_until, and that a replacement or a shorter retention is allowed by the backup compliance policy.
+func resourceMongoDBAtlasCloudBackupSnapshotCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if err := cloudBackupSnapshotComplianceCustomizeDiff(ctx, d, meta); err != nil {
+		return err
+	}
+
+	if !d.NewValueKnown("lock_until") || !d.NewValueKnown("retention_in_days") {
+		return nil
+	}
+
+	lockUntil := d.Get("lock_until").(string)
+	if lockUntil == "" {
+		return nil
+	}
+
+	createdAt := time.Now()
+	if v := d.Get("created_at").(string); v != "" {
+		if t, err := time.Parse(time.RFC3339, v); err == nil {
+			createdAt = t
+		}
+	}
+
+	return validateSnapshotLock(createdAt, d.Get("retention_in_days").(int), lockUntil)
+}
+
+// cloudBackupSnapshotComplianceCustomizeDiff denies replacing a snapshot or shortening its retention in a project
+// with an active backup compliance policy, since Atlas doesn't allow to delete the snapshot or decrease its retention.
+func cloudBackupSnapshotComplianceCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	oldRetention, newRetention := d.GetChange("retention_in_days")
+	replaced := d.HasChanges("project_id", "cluster_name", "description") && !d.Get("retain_on_destroy").(bool)
+	decreased := d.NewValueKnown("retention_in_days") && newRetention.(int) < oldRetention.(int)
+	if !replaced && !decreased {
+		return nil
+	}
+
+	ids := decodeStateID(d.Id())
+	compliancePolicy, err := getActiveBackupCompliancePolicy(ctx, meta.(*MongoDBClient).Atlas, ids["project_id"])
+	if err != nil {
+		log.Printf("[WARN] unable to get the backup compliance policy of project (%s), skipping snapshot checks: %s", ids["project_id"], err)
+		return nil
+	}
+	if compliancePolicy == nil {
+		return nil
+	}
+
+	if replaced {
+		return fmt.Errorf("snapshot (%s) can't be replaced, the Backup Compliance Policy of project (%s) doesn't allow to delete snapshots, set `retain_on_destroy` to keep it in Atlas", ids["snapshot_id"], ids["project_id"])
+	}
+
+	return fmt.Errorf("`retention_in_days` of snapshot (%s) can't be decreased, the Backup Compliance Policy of project (%s) doesn't allow it", ids["snapshot_id"], ids["project_id"])
+}
+
+// validateSnapshotDeletionCompliance returns an error if the project has an active backup compliance policy, which
+// doesn't allow to delete snapshots.
+func validateSnapshotDeletionCompliance(ctx context.Context, conn *matlas.Client, projectID, snapshotID string) error {
+	compliancePolicy, err := getActiveBackupCompliancePolicy(ctx, conn, projectID)
+	if err != nil {
+		log.Printf("[WARN] unable to get the backup compliance policy of project (%s), skipping snapshot checks: %s", projectID, err)
+		return nil
+	}
+	if compliancePolicy == nil {
+		return nil
+	}
+
+	return fmt.Errorf("snapshot (%s) can't be deleted, the Backup Compliance Policy of project (%s) doesn't allow to delete snapshots, set `retain_on_destroy` to only remove it from the state", snapshotID, projectID)
+}
+
+// validateSnapshotLock returns an error if a snapshot created at createdAt with a retention of retentionInDays
+// expires before lockUntil.
+func validateSnapshotLock(createdAt time.Time, retentionInDays int, lockUntil string) error {
+	until, err := time.Parse(time.RFC3339, lockUntil)
+	if err != nil {
+		return fmt.Errorf("`lock_until` must be a RFC3339 timestamp: %s", err)
+	}
+
+	expiresAt := createdAt.AddDate(0, 0, retentionInDays)
+	if expiresAt.Before(until) {
+		return fmt.Errorf("`retention_in_days` of %d expires the snapshot at %s, before `lock_until` (%s)", retentionInDays, expiresAt.Format(time.RFC3339), lockUntil)
+	}
+
+	return nil
+}
+
 func resourceCloudBackupSnapshotRefreshFunc(ctx context.Context, requestParameters *matlas.SnapshotReqPathParameters, client *matlas.Client) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		c, resp, err := client.CloudProviderSnapshots.GetOneCloudProviderSnapshot(ctx, requestParameters)
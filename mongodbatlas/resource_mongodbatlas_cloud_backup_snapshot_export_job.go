@@ -4,9 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log"
+	"net/http"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	matlas "go.mongodb.org/atlas/mongodbatlas"
 )
@@ -15,14 +19,26 @@ func resourceMongoDBAtlasCloudBackupSnapshotExportJob() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceMongoDBAtlasCloudBackupSnapshotExportJobCreate,
 		ReadContext:   resourceMongoDBAtlasCloudBackupSnapshotExportJobRead,
+		UpdateContext: resourceMongoDBAtlasCloudBackupSnapshotExportJobUpdate,
 		DeleteContext: schema.NoopContext,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceMongoDBAtlasCloudBackupSnapshotExportJobImportState,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(12 * time.Hour),
+		},
 		Schema: returnCloudBackupSnapshotExportJobSchema(),
 	}
 }
 
+const (
+	exportJobStateQueued     = "Queued"
+	exportJobStateInProgress = "InProgress"
+	exportJobStateSuccessful = "Successful"
+	exportJobStateFailed     = "Failed"
+	exportJobStateCancelled  = "Cancelled"
+)
+
 func returnCloudBackupSnapshotExportJobSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"id": {
@@ -84,6 +100,14 @@ func returnCloudBackupSnapshotExportJobSchema() map[string]*schema.Schema {
 						Type:     schema.TypeString,
 						Computed: true,
 					},
+					"state": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"err_msg": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
 				},
 			},
 		},
@@ -115,6 +139,18 @@ func returnCloudBackupSnapshotExportJobSchema() map[string]*schema.Schema {
 			Type:     schema.TypeString,
 			Computed: true,
 		},
+		"s3_prefix": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"manifest_location": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"wait_for_completion": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
 	}
 }
 
@@ -152,7 +188,11 @@ func resourceMongoDBAtlasCloudBackupSnapshotExportJobRead(ctx context.Context, d
 		return diag.Errorf("error setting `custom_data` for snapshot export job (%s): %s", d.Id(), err)
 	}
 
-	if err := d.Set("components", flattenExportJobsComponents(exportJob.Components)); err != nil {
+	components, err := flattenExportJobComponentsWithState(ctx, conn, projectID, clusterName, exportJob.Components)
+	if err != nil {
+		return diag.Errorf("error getting components of snapshot export job (%s): %s", d.Id(), err)
+	}
+	if err := d.Set("components", components); err != nil {
 		return diag.Errorf("error setting `components` for snapshot export job (%s): %s", d.Id(), err)
 	}
 
@@ -190,9 +230,57 @@ func resourceMongoDBAtlasCloudBackupSnapshotExportJobRead(ctx context.Context, d
 		return diag.Errorf("error setting `prefix` for snapshot export job (%s): %s", d.Id(), err)
 	}
 
+	s3Prefix, manifestLocation := "", ""
+	if exportJob.Prefix != "" && exportJob.State == exportJobStateSuccessful {
+		bucket, _, err := conn.CloudProviderSnapshotExportBuckets.Get(ctx, projectID, exportJob.ExportBucketID)
+		if err != nil {
+			return diag.Errorf("error getting export bucket (%s) of snapshot export job (%s): %s", exportJob.ExportBucketID, d.Id(), err)
+		}
+		s3Prefix = fmt.Sprintf("s3://%s/%s", bucket.BucketName, strings.TrimPrefix(exportJob.Prefix, "/"))
+		manifestLocation = s3Prefix + "/.complete"
+	}
+
+	if err := d.Set("s3_prefix", s3Prefix); err != nil {
+		return diag.Errorf("error setting `s3_prefix` for snapshot export job (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("manifest_location", manifestLocation); err != nil {
+		return diag.Errorf("error setting `manifest_location` for snapshot export job (%s): %s", d.Id(), err)
+	}
+
 	return nil
 }
 
+// flattenExportJobComponentsWithState returns the components of a sharded cluster export, including the state of the
+// export of each replica set, which Atlas reports as a separate export job.
+func flattenExportJobComponentsWithState(ctx context.Context, conn *matlas.Client, projectID, clusterName string,
+	components []*matlas.CloudProviderSnapshotExportJobComponent) ([]map[string]interface{}, error) {
+	results := flattenExportJobsComponents(components)
+
+	for i := range results {
+		results[i]["state"] = ""
+		results[i]["err_msg"] = ""
+
+		exportID := results[i]["export_id"].(string)
+		if exportID == "" {
+			continue
+		}
+
+		component, resp, err := conn.CloudProviderSnapshotExportJobs.Get(ctx, projectID, clusterName, exportID)
+		if err != nil {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				continue
+			}
+			return nil, err
+		}
+
+		results[i]["state"] = component.State
+		results[i]["err_msg"] = component.ErrMsg
+	}
+
+	return results, nil
+}
+
 func flattenExportJobsComponents(components []*matlas.CloudProviderSnapshotExportJobComponent) []map[string]interface{} {
 	if len(components) == 0 {
 		return nil
@@ -250,9 +338,63 @@ func resourceMongoDBAtlasCloudBackupSnapshotExportJobCreate(ctx context.Context,
 		"export_job_id": jobResponse.ID,
 	}))
 
+	if d.Get("wait_for_completion").(bool) {
+		stateConf := &retry.StateChangeConf{
+			Pending:    []string{exportJobStateQueued, exportJobStateInProgress},
+			Target:     []string{exportJobStateSuccessful},
+			Refresh:    resourceCloudBackupSnapshotExportJobRefreshFunc(ctx, conn, projectID, clusterName, jobResponse.ID),
+			Timeout:    d.Timeout(schema.TimeoutCreate),
+			MinTimeout: 30 * time.Second,
+			Delay:      1 * time.Minute,
+		}
+
+		// the job is kept in the state even if it fails, so its details can be inspected and it's replaced on the next apply
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			diags := resourceMongoDBAtlasCloudBackupSnapshotExportJobRead(ctx, d, meta)
+			return append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "error waiting for the snapshot export job to complete",
+				Detail:   err.Error(),
+			})
+		}
+	}
+
 	return resourceMongoDBAtlasCloudBackupSnapshotExportJobRead(ctx, d, meta)
 }
 
+func resourceMongoDBAtlasCloudBackupSnapshotExportJobUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceMongoDBAtlasCloudBackupSnapshotExportJobRead(ctx, d, meta)
+}
+
+func resourceCloudBackupSnapshotExportJobRefreshFunc(ctx context.Context, conn *matlas.Client, projectID, clusterName, exportID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		exportJob, _, err := conn.CloudProviderSnapshotExportJobs.Get(ctx, projectID, clusterName, exportID)
+		if err != nil {
+			return nil, "", err
+		}
+
+		log.Printf("[DEBUG] status for MongoDB snapshot export job %s: %s", exportID, exportJob.State)
+
+		// a failed component fails the export, even if the other components are still running
+		components, err := flattenExportJobComponentsWithState(ctx, conn, projectID, clusterName, exportJob.Components)
+		if err != nil {
+			return nil, "", err
+		}
+		for _, component := range components {
+			if component["state"] == exportJobStateFailed {
+				return nil, exportJobStateFailed, fmt.Errorf("export of replica set (%s) failed: %s", component["replica_set_name"], component["err_msg"])
+			}
+		}
+
+		switch exportJob.State {
+		case exportJobStateFailed, exportJobStateCancelled:
+			return nil, exportJob.State, fmt.Errorf("snapshot export job (%s) is %s: %s", exportID, strings.ToLower(exportJob.State), exportJob.ErrMsg)
+		}
+
+		return exportJob, exportJob.State, nil
+	}
+}
+
 func expandExportJobCustomData(d *schema.ResourceData) []*matlas.CloudProviderSnapshotExportJobCustomData {
 	customData := d.Get("custom_data").(*schema.Set)
 	res := make([]*matlas.CloudProviderSnapshotExportJobCustomData, customData.Len())
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -26,7 +27,7 @@ func TestAccBackupRSBackupSnapshotExportJob_basic(t *testing.T) {
 		CheckDestroy:             testAccCheckMongoDBAtlasBackupSnapshotExportJobDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccMongoDBAtlasBackupSnapshotExportJobConfig(projectID, bucketName, iamRoleID),
+				Config: testAccMongoDBAtlasBackupSnapshotExportJobConfig(projectID, bucketName, iamRoleID, false),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckMongoDBAtlasBackupSnapshotExportJobExists(resourceName, &snapshotExportJob),
 					resource.TestCheckResourceAttr(resourceName, "project_id", projectID),
@@ -38,6 +39,34 @@ func TestAccBackupRSBackupSnapshotExportJob_basic(t *testing.T) {
 	})
 }
 
+func TestAccBackupRSBackupSnapshotExportJob_waitForCompletion(t *testing.T) {
+	SkipTestExtCred(t)
+	var (
+		snapshotExportJob matlas.CloudProviderSnapshotExportJob
+		resourceName      = "mongodbatlas_cloud_backup_snapshot_export_job.test"
+		projectID         = os.Getenv("MONGODB_ATLAS_PROJECT_ID")
+		bucketName        = os.Getenv("AWS_S3_BUCKET")
+		iamRoleID         = os.Getenv("IAM_ROLE_ID")
+	)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasBackupSnapshotExportJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasBackupSnapshotExportJobConfig(projectID, bucketName, iamRoleID, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasBackupSnapshotExportJobExists(resourceName, &snapshotExportJob),
+					resource.TestCheckResourceAttr(resourceName, "state", "Successful"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_completion", "true"),
+					resource.TestMatchResourceAttr(resourceName, "s3_prefix", regexp.MustCompile("^s3://"+bucketName+"/exported_snapshots/")),
+					resource.TestMatchResourceAttr(resourceName, "manifest_location", regexp.MustCompile(`/\.complete$`)),
+				),
+			},
+		},
+	})
+}
+
 func TestAccBackupRSBackupSnapshotExportJob_importBasic(t *testing.T) {
 	SkipTestExtCred(t)
 	var (
@@ -53,7 +82,7 @@ func TestAccBackupRSBackupSnapshotExportJob_importBasic(t *testing.T) {
 		CheckDestroy:             testAccCheckMongoDBAtlasBackupSnapshotExportJobDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccMongoDBAtlasBackupSnapshotExportJobConfig(projectID, bucketName, iamRoleID),
+				Config: testAccMongoDBAtlasBackupSnapshotExportJobConfig(projectID, bucketName, iamRoleID, false),
 			},
 			{
 				ResourceName:      resourceName,
@@ -122,7 +151,7 @@ func testAccCheckMongoDBAtlasBackupSnapshotExportJobImportStateIDFunc(resourceNa
 	}
 }
 
-func testAccMongoDBAtlasBackupSnapshotExportJobConfig(projectID, bucketName, iamRoleID string) string {
+func testAccMongoDBAtlasBackupSnapshotExportJobConfig(projectID, bucketName, iamRoleID string, waitForCompletion bool) string {
 	return fmt.Sprintf(`
 resource "mongodbatlas_cluster" "my_cluster" {
   project_id   = var.project_id
@@ -153,10 +182,11 @@ resource "mongodbatlas_cloud_backup_snapshot_export_job" "test" {
   cluster_name = mongodbatlas_cluster.my_cluster.name
   snapshot_id = mongodbatlas_cloud_backup_snapshot.test.snapshot_id
   export_bucket_id = mongodbatlas_cloud_backup_snapshot_export_bucket.test.export_bucket_id
+  wait_for_completion = %[4]t
 
   custom_data {
     key   = "exported by"
     value = "myName"
   }
-}`, projectID, bucketName, iamRoleID)
+}`, projectID, bucketName, iamRoleID, waitForCompletion)
 }
@@ -7,8 +7,10 @@ import (
 	"log"
 	"net/http"
 	"regexp"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/spf13/cast"
 	matlas "go.mongodb.org/atlas/mongodbatlas"
@@ -18,10 +20,14 @@ func resourceMongoDBAtlasCloudBackupSnapshotRestoreJob() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceMongoDBAtlasCloudBackupSnapshotRestoreJobCreate,
 		ReadContext:   resourceMongoDBAtlasCloudBackupSnapshotRestoreJobRead,
+		UpdateContext: resourceMongoDBAtlasCloudBackupSnapshotRestoreJobUpdate,
 		DeleteContext: resourceMongoDBAtlasCloudBackupSnapshotRestoreJobDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceMongoDBAtlasCloudBackupSnapshotRestoreJobImportState,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(3 * time.Hour),
+		},
 		Schema: map[string]*schema.Schema{
 			"project_id": {
 				Type:     schema.TypeString,
@@ -35,7 +41,8 @@ func resourceMongoDBAtlasCloudBackupSnapshotRestoreJob() *schema.Resource {
 			},
 			"snapshot_id": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 			"delivery_type_config": {
@@ -123,6 +130,18 @@ func resourceMongoDBAtlasCloudBackupSnapshotRestoreJob() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"failed": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"elapsed_seconds": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"wait_for_completion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 		},
 	}
 }
@@ -142,6 +161,9 @@ func resourceMongoDBAtlasCloudBackupSnapshotRestoreJobCreate(ctx context.Context
 	}
 
 	snapshotReq := buildRequestSnapshotReq(d)
+	if d.Get("snapshot_id").(string) == "" && snapshotReq.DeliveryType != "pointInTime" {
+		return diag.FromErr(errors.New("snapshot_id must be set for automated and download restore jobs"))
+	}
 
 	cloudProviderSnapshotRestoreJob, _, err := conn.CloudProviderSnapshotRestoreJobs.Create(ctx, requestParameters, snapshotReq)
 	if err != nil {
@@ -154,6 +176,87 @@ func resourceMongoDBAtlasCloudBackupSnapshotRestoreJobCreate(ctx context.Context
 		"snapshot_restore_job_id": cloudProviderSnapshotRestoreJob.ID,
 	}))
 
+	if d.Get("wait_for_completion").(bool) {
+		requestParameters.JobID = cloudProviderSnapshotRestoreJob.ID
+		stateConf := &retry.StateChangeConf{
+			Pending:    []string{"pending"},
+			Target:     []string{"completed"},
+			Refresh:    resourceCloudBackupSnapshotRestoreJobRefreshFunc(ctx, requestParameters, conn),
+			Timeout:    d.Timeout(schema.TimeoutCreate),
+			MinTimeout: 30 * time.Second,
+			Delay:      1 * time.Minute,
+		}
+
+		// the job is kept in the state even if it fails, so its details can be inspected and it's replaced on the next apply
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			diags := resourceMongoDBAtlasCloudBackupSnapshotRestoreJobRead(ctx, d, meta)
+			return append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "error waiting for the restore job to complete",
+				Detail:   err.Error(),
+			})
+		}
+	}
+
+	return resourceMongoDBAtlasCloudBackupSnapshotRestoreJobRead(ctx, d, meta)
+}
+
+func resourceCloudBackupSnapshotRestoreJobRefreshFunc(ctx context.Context, requestParameters *matlas.SnapshotReqPathParameters, client *matlas.Client) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		job, _, err := client.CloudProviderSnapshotRestoreJobs.Get(ctx, requestParameters)
+		if err != nil {
+			return nil, "failed", err
+		}
+
+		status := snapshotRestoreJobStatus(job)
+		log.Printf("[DEBUG] status for MongoDB restore job %s: %s", requestParameters.JobID, status)
+
+		switch status {
+		case "failed", "cancelled", "expired":
+			return nil, status, fmt.Errorf("restore job (%s) of cluster (%s) is %s, delivery type: %s, target cluster: %s, created at: %s",
+				job.ID, requestParameters.ClusterName, status, job.DeliveryType, job.TargetClusterName, job.CreatedAt)
+		}
+
+		return job, status, nil
+	}
+}
+
+func snapshotRestoreJobStatus(job *matlas.CloudProviderSnapshotRestoreJob) string {
+	switch {
+	case job.Failed != nil && *job.Failed:
+		return "failed"
+	case job.Cancelled:
+		return "cancelled"
+	case job.Expired:
+		return "expired"
+	case job.FinishedAt != "":
+		return "completed"
+	default:
+		return "pending"
+	}
+}
+
+// snapshotRestoreJobElapsedSeconds returns the time the restore job took to finish, from its timestamps. It returns
+// nil while the job is running, so the value in the state doesn't change on every refresh.
+func snapshotRestoreJobElapsedSeconds(job *matlas.CloudProviderSnapshotRestoreJob) *int {
+	if job.FinishedAt == "" {
+		return nil
+	}
+
+	createdAt, err := time.Parse(time.RFC3339, job.CreatedAt)
+	if err != nil {
+		return nil
+	}
+	finishedAt, err := time.Parse(time.RFC3339, job.FinishedAt)
+	if err != nil {
+		return nil
+	}
+
+	elapsedSeconds := int(finishedAt.Sub(createdAt).Seconds())
+	return &elapsedSeconds
+}
+
+func resourceMongoDBAtlasCloudBackupSnapshotRestoreJobUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	return resourceMongoDBAtlasCloudBackupSnapshotRestoreJobRead(ctx, d, meta)
 }
 
@@ -210,6 +313,18 @@ func resourceMongoDBAtlasCloudBackupSnapshotRestoreJobRead(ctx context.Context,
 		return diag.FromErr(fmt.Errorf("error setting `snapshot_restore_job_id` for cloudProviderSnapshotRestoreJob (%s): %s", ids["snapshot_restore_job_id"], err))
 	}
 
+	if err = d.Set("failed", snapshotReq.Failed != nil && *snapshotReq.Failed); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting `failed` for cloudProviderSnapshotRestoreJob (%s): %s", ids["snapshot_restore_job_id"], err))
+	}
+
+	var elapsedSeconds interface{}
+	if v := snapshotRestoreJobElapsedSeconds(snapshotReq); v != nil {
+		elapsedSeconds = *v
+	}
+	if err = d.Set("elapsed_seconds", elapsedSeconds); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting `elapsed_seconds` for cloudProviderSnapshotRestoreJob (%s): %s", ids["snapshot_restore_job_id"], err))
+	}
+
 	return nil
 }
 
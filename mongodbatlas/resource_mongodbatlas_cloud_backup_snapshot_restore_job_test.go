@@ -10,9 +10,41 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/mwielbut/pointy"
 	matlas "go.mongodb.org/atlas/mongodbatlas"
 )
 
+func TestSnapshotRestoreJobElapsedSeconds(t *testing.T) {
+	testCases := []struct {
+		name     string
+		job      matlas.CloudProviderSnapshotRestoreJob
+		expected *int
+	}{
+		{
+			name:     "finished",
+			job:      matlas.CloudProviderSnapshotRestoreJob{CreatedAt: "2023-08-01T10:00:00Z", FinishedAt: "2023-08-01T10:05:30Z"},
+			expected: pointy.Int(330),
+		},
+		{
+			name: "running",
+			job:  matlas.CloudProviderSnapshotRestoreJob{CreatedAt: "2023-08-01T10:00:00Z"},
+		},
+		{
+			name: "invalid timestamp",
+			job:  matlas.CloudProviderSnapshotRestoreJob{CreatedAt: "invalid", FinishedAt: "2023-08-01T10:05:30Z"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := snapshotRestoreJobElapsedSeconds(&tc.job)
+			if (got == nil) != (tc.expected == nil) || (got != nil && *got != *tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
 func TestAccBackupRSCloudBackupSnapshotRestoreJob_basic(t *testing.T) {
 	var (
 		cloudBackupSnapshotRestoreJob     = matlas.CloudProviderSnapshotRestoreJob{}
@@ -74,7 +106,7 @@ func TestAccBackupRSCloudBackupSnapshotRestoreJob_basicDownload(t *testing.T) {
 		CheckDestroy:             testAccCheckMongoDBAtlasCloudBackupSnapshotRestoreJobDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccMongoDBAtlasCloudBackupSnapshotRestoreJobConfigDownload(orgID, projectName, clusterName, description, retentionInDays),
+				Config: testAccMongoDBAtlasCloudBackupSnapshotRestoreJobConfigDownload(orgID, projectName, clusterName, description, retentionInDays, false),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckMongoDBAtlasCloudBackupSnapshotRestoreJobExists(resourceName, &cloudBackupSnapshotRestoreJob),
 					testAccCheckMongoDBAtlasCloudBackupSnapshotRestoreJobAttributes(&cloudBackupSnapshotRestoreJob, "download"),
@@ -85,6 +117,36 @@ func TestAccBackupRSCloudBackupSnapshotRestoreJob_basicDownload(t *testing.T) {
 	})
 }
 
+func TestAccBackupRSCloudBackupSnapshotRestoreJob_waitForCompletion(t *testing.T) {
+	var (
+		cloudBackupSnapshotRestoreJob = matlas.CloudProviderSnapshotRestoreJob{}
+		resourceName                  = "mongodbatlas_cloud_backup_snapshot_restore_job.test"
+		orgID                         = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		projectName                   = acctest.RandomWithPrefix("test-acc")
+		clusterName                   = fmt.Sprintf("test-acc-%s", acctest.RandString(10))
+		description                   = fmt.Sprintf("My description in %s", clusterName)
+		retentionInDays               = "1"
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasCloudBackupSnapshotRestoreJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasCloudBackupSnapshotRestoreJobConfigDownload(orgID, projectName, clusterName, description, retentionInDays, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasCloudBackupSnapshotRestoreJobExists(resourceName, &cloudBackupSnapshotRestoreJob),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_completion", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "finished_at"),
+					resource.TestCheckResourceAttrSet(resourceName, "elapsed_seconds"),
+					resource.TestCheckResourceAttr(resourceName, "failed", "false"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccBackupRSCloudBackupSnapshotRestoreJobWithPointTime_basic(t *testing.T) {
 	SkipTestForCI(t)
 	var (
@@ -259,7 +321,7 @@ data "mongodbatlas_cloud_backup_snapshot_restore_jobs" "pagination" {
 	`, orgID, projectName, clusterName, description, retentionInDays, targetProjectName, targetClusterName)
 }
 
-func testAccMongoDBAtlasCloudBackupSnapshotRestoreJobConfigDownload(orgID, projectName, clusterName, description, retentionInDays string) string {
+func testAccMongoDBAtlasCloudBackupSnapshotRestoreJobConfigDownload(orgID, projectName, clusterName, description, retentionInDays string, waitForCompletion bool) string {
 	return fmt.Sprintf(`
 resource "mongodbatlas_project" "backup_project" {
 	name   = %[2]q
@@ -287,11 +349,13 @@ resource "mongodbatlas_cloud_backup_snapshot_restore_job" "test" {
   cluster_name = mongodbatlas_cloud_backup_snapshot.test.cluster_name
   snapshot_id  = mongodbatlas_cloud_backup_snapshot.test.id
 
+  wait_for_completion = %[6]t
+
   delivery_type_config {
     download = true
   }
 }
-	`, orgID, projectName, clusterName, description, retentionInDays)
+	`, orgID, projectName, clusterName, description, retentionInDays, waitForCompletion)
 }
 
 func testAccMongoDBAtlasCloudBackupSnapshotRestoreJobConfigPointInTime(orgID, projectName, clusterName, description, retentionInDays, targetProjectName string, pointTimeUTC int64) string {
@@ -5,12 +5,16 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"regexp"
 	"testing"
+	"time"
 
 	"github.com/go-test/deep"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	atlasSDK "go.mongodb.org/atlas-sdk/v20230201006/admin"
 	matlas "go.mongodb.org/atlas/mongodbatlas"
 )
 
@@ -20,6 +24,7 @@ func TestAccBackupRSCloudBackupSnapshot_basic(t *testing.T) {
 		resourceName                      = "mongodbatlas_cloud_backup_snapshot.test"
 		snapshotsDataSourceName           = "data.mongodbatlas_cloud_backup_snapshots.test"
 		snapshotsDataSourcePaginationName = "data.mongodbatlas_cloud_backup_snapshots.pagination"
+		snapshotsDataSourceFilteredName   = "data.mongodbatlas_cloud_backup_snapshots.filtered"
 		dataSourceName                    = "data.mongodbatlas_cloud_backup_snapshot.test"
 		orgID                             = os.Getenv("MONGODB_ATLAS_ORG_ID")
 		projectName                       = acctest.RandomWithPrefix("test-acc")
@@ -46,6 +51,9 @@ func TestAccBackupRSCloudBackupSnapshot_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(dataSourceName, "description"),
 					resource.TestCheckResourceAttrSet(snapshotsDataSourceName, "results.#"),
 					resource.TestCheckResourceAttrSet(snapshotsDataSourcePaginationName, "results.#"),
+					resource.TestCheckResourceAttr(snapshotsDataSourceFilteredName, "results.#", "1"),
+					resource.TestCheckResourceAttr(snapshotsDataSourceFilteredName, "results.0.snapshot_type", "onDemand"),
+					resource.TestCheckResourceAttrPair(snapshotsDataSourceFilteredName, "results.0.id", resourceName, "snapshot_id"),
 				),
 			},
 			{
@@ -59,6 +67,49 @@ func TestAccBackupRSCloudBackupSnapshot_basic(t *testing.T) {
 	})
 }
 
+func TestAccBackupRSCloudBackupSnapshot_retention(t *testing.T) {
+	var (
+		cloudBackupSnapshot = matlas.CloudProviderSnapshot{}
+		resourceName        = "mongodbatlas_cloud_backup_snapshot.test"
+		orgID               = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		projectName         = acctest.RandomWithPrefix("test-acc")
+		clusterName         = fmt.Sprintf("test-acc-%s", acctest.RandString(10))
+		description         = "My description in my cluster"
+		lockUntil           = time.Now().AddDate(0, 0, 3).UTC().Format(time.RFC3339)
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasCloudBackupSnapshotRetentionConfig(orgID, projectName, clusterName, description, 4, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasCloudBackupSnapshotExists(resourceName, &cloudBackupSnapshot),
+					resource.TestCheckResourceAttr(resourceName, "retention_in_days", "4"),
+					resource.TestCheckResourceAttr(resourceName, "retain_on_destroy", "true"),
+				),
+			},
+			{
+				Config:      testAccMongoDBAtlasCloudBackupSnapshotRetentionConfig(orgID, projectName, clusterName, description, 2, lockUntil),
+				ExpectError: regexp.MustCompile("before `lock_until`"),
+			},
+			{
+				Config: testAccMongoDBAtlasCloudBackupSnapshotRetentionConfig(orgID, projectName, clusterName, description, 7, lockUntil),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasCloudBackupSnapshotExists(resourceName, &cloudBackupSnapshot),
+					resource.TestCheckResourceAttr(resourceName, "retention_in_days", "7"),
+					resource.TestCheckResourceAttr(resourceName, "lock_until", lockUntil),
+				),
+			},
+			{
+				Config: testAccMongoDBAtlasCloudBackupSnapshotRetentionConfig(orgID, projectName, clusterName, description, 7, ""),
+			},
+		},
+	})
+}
+
 func testAccCheckMongoDBAtlasCloudBackupSnapshotExists(resourceName string, cloudBackupSnapshot *matlas.CloudProviderSnapshot) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := testAccProviderSdkV2.Meta().(*MongoDBClient).Atlas
@@ -182,11 +233,77 @@ data "mongodbatlas_cloud_backup_snapshots" "pagination" {
 	items_per_page = 5
 }
 
+data "mongodbatlas_cloud_backup_snapshots" "filtered" {
+	project_id    = mongodbatlas_cloud_backup_snapshot.test.project_id
+	cluster_name  = mongodbatlas_cloud_backup_snapshot.test.cluster_name
+	snapshot_type = "onDemand"
+	most_recent   = true
+}
+
 
 
 	`, orgID, projectName, clusterName, description, retentionInDays)
 }
 
+func testAccMongoDBAtlasCloudBackupSnapshotRetentionConfig(orgID, projectName, clusterName, description string, retentionInDays int, lockUntil string) string {
+	var lock string
+	if lockUntil != "" {
+		lock = fmt.Sprintf("lock_until = %q", lockUntil)
+	}
+
+	return fmt.Sprintf(`
+resource "mongodbatlas_project" "backup_project" {
+	name   = %[2]q
+	org_id = %[1]q
+}
+resource "mongodbatlas_cluster" "my_cluster" {
+  project_id   = mongodbatlas_project.backup_project.id
+  name         = %[3]q
+  disk_size_gb = 10
+
+  // Provider Settings "block"
+  provider_name               = "AWS"
+  provider_region_name        = "EU_CENTRAL_1"
+  provider_instance_size_name = "M10"
+  cloud_backup                = true //enable cloud backup snapshots
+}
+
+resource "mongodbatlas_cloud_backup_snapshot" "test" {
+  project_id        = mongodbatlas_cluster.my_cluster.project_id
+  cluster_name      = mongodbatlas_cluster.my_cluster.name
+  description       = %[4]q
+  retention_in_days = %[5]d
+  retain_on_destroy = true
+  %[6]s
+}
+	`, orgID, projectName, clusterName, description, retentionInDays, lock)
+}
+
+func TestValidateSnapshotLock(t *testing.T) {
+	createdAt := time.Date(2023, 8, 1, 10, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name            string
+		lockUntil       string
+		retentionInDays int
+		expectError     bool
+	}{
+		{name: "retention after lock", retentionInDays: 10, lockUntil: "2023-08-10T10:00:00Z"},
+		{name: "retention at lock", retentionInDays: 9, lockUntil: "2023-08-10T10:00:00Z"},
+		{name: "retention before lock", retentionInDays: 8, lockUntil: "2023-08-10T10:00:00Z", expectError: true},
+		{name: "invalid lock", retentionInDays: 8, lockUntil: "2023-08-10", expectError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateSnapshotLock(createdAt, tc.retentionInDays, tc.lockUntil)
+			if tc.expectError != (err != nil) {
+				t.Errorf("validateSnapshotLock(%d, %q) returned error %v, expected error: %t", tc.retentionInDays, tc.lockUntil, err, tc.expectError)
+			}
+		})
+	}
+}
+
 func TestResourceMongoDBAtlasCloudBackupSnapshot_snapshotID(t *testing.T) {
 	got, err := splitSnapshotImportID("5cf5a45a9ccf6400e60981b6-projectname-environment-mongo-global-cluster-5cf5a45a9ccf6400e60981b7")
 	if err != nil {
@@ -207,3 +324,99 @@ func TestResourceMongoDBAtlasCloudBackupSnapshot_snapshotID(t *testing.T) {
 		t.Error("splitSnapshotImportID expected to have error")
 	}
 }
+
+func TestFilterSnapshots(t *testing.T) {
+	timeAt := func(value string) *time.Time {
+		parsed, _ := time.Parse(time.RFC3339, value)
+		return &parsed
+	}
+	snapshots := []atlasSDK.DiskBackupReplicaSet{
+		{Id: pointer("1"), CreatedAt: timeAt("2023-07-01T00:00:00Z"), FrequencyType: pointer("weekly"), Status: pointer("completed"), PolicyItems: []string{"a"}},
+		{Id: pointer("2"), CreatedAt: timeAt("2023-07-08T00:00:00Z"), FrequencyType: pointer("weekly"), Status: pointer("completed"), PolicyItems: []string{"a"}},
+		{Id: pointer("3"), CreatedAt: timeAt("2023-07-09T00:00:00Z"), FrequencyType: pointer("daily"), Status: pointer("completed"), PolicyItems: []string{"b"}},
+		{Id: pointer("4"), CreatedAt: timeAt("2023-07-15T00:00:00Z"), FrequencyType: pointer("weekly"), Status: pointer("inProgress"), PolicyItems: []string{"a"}},
+	}
+
+	testCases := []struct {
+		name     string
+		config   map[string]interface{}
+		expected []string
+	}{
+		{name: "no filter", config: map[string]interface{}{}, expected: []string{"1", "2", "3", "4"}},
+		{name: "sorted by creation time", config: map[string]interface{}{"status": "COMPLETED"}, expected: []string{"3", "2", "1"}},
+		{name: "policy item", config: map[string]interface{}{"policy_item_id": "b"}, expected: []string{"3"}},
+		{
+			name:     "latest completed weekly snapshot before date",
+			config:   map[string]interface{}{"frequency_type": "weekly", "status": "completed", "created_before": "2023-07-14T00:00:00Z", "most_recent": true},
+			expected: []string{"2"},
+		},
+		{name: "created after", config: map[string]interface{}{"created_after": "2023-07-08T00:00:00Z"}, expected: []string{"4", "3"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, dataSourceMongoDBAtlasCloudBackupSnapshots().Schema, tc.config)
+			filter, err := newSnapshotFilter(d)
+			if err != nil {
+				t.Fatalf("newSnapshotFilter returned error: %s", err)
+			}
+
+			results := filterSnapshots(snapshots, filter, func(snapshot *atlasSDK.DiskBackupReplicaSet) snapshotFilterItem {
+				return snapshotFilterItem{
+					createdAt:     snapshot.CreatedAt,
+					frequencyType: snapshot.GetFrequencyType(),
+					status:        snapshot.GetStatus(),
+					policyItems:   snapshot.PolicyItems,
+				}
+			})
+
+			ids := make([]string, len(results))
+			for i := range results {
+				ids[i] = results[i].GetId()
+			}
+			if diff := deep.Equal(ids, tc.expected); diff != nil {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestFlattenCloudBackupSnapshots_shardedCluster(t *testing.T) {
+	snapshots := []atlasSDK.DiskBackupReplicaSet{
+		{Id: pointer("1"), Type: pointer("replicaSet"), ReplicaSetName: pointer("rs0")},
+		{Id: pointer("2"), Type: pointer("shardedCluster")},
+	}
+	shardedSnapshots := []atlasSDK.DiskBackupShardedClusterSnapshot{
+		{
+			Id:          pointer("2"),
+			SnapshotIds: []string{"2a", "2b"},
+			Members: []atlasSDK.DiskBackupShardedClusterSnapshotMember{
+				{Id: "2a", CloudProvider: "AWS", ReplicaSetName: "shard-0"},
+				{Id: "2b", CloudProvider: "AWS", ReplicaSetName: "config"},
+			},
+		},
+	}
+
+	results := flattenCloudBackupSnapshots(snapshots, shardedSnapshots)
+	if len(results[0]["members"].([]map[string]interface{})) != 0 || len(results[0]["snapshot_ids"].([]string)) != 0 {
+		t.Errorf("expected no members for a replica set snapshot, got %v", results[0])
+	}
+	if diff := deep.Equal(results[1]["snapshot_ids"], []string{"2a", "2b"}); diff != nil {
+		t.Error(diff)
+	}
+	members := results[1]["members"].([]map[string]interface{})
+	if len(members) != 2 || members[1]["replica_set_name"] != "config" {
+		t.Errorf("unexpected members: %v", members)
+	}
+}
+
+func TestNewSnapshotFilter_sharedTierSchema(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, dataSourceMongoDBAtlasSharedTierSnapshots().Schema, map[string]interface{}{"status": "COMPLETED"})
+	filter, err := newSnapshotFilter(d)
+	if err != nil {
+		t.Fatalf("newSnapshotFilter returned error: %s", err)
+	}
+	if filter == nil || filter.status != "COMPLETED" || filter.snapshotType != "" {
+		t.Errorf("unexpected filter: %+v", filter)
+	}
+}
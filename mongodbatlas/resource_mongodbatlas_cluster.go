@@ -158,6 +158,7 @@ func resourceMongoDBAtlasCluster() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"pre_change_snapshot": preChangeSnapshotSchema(),
 			"backing_provider_name": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -886,6 +887,13 @@ func resourceMongoDBAtlasClusterUpdate(ctx context.Context, d *schema.ResourceDa
 
 	timeout := d.Timeout(schema.TimeoutUpdate)
 
+	if !reflect.DeepEqual(cluster, clusterChangeDetect) && d.HasChanges(clusterPreChangeSnapshotAttributes...) {
+		cloudBackup, _ := d.GetChange("cloud_backup")
+		if err := takeClusterPreChangeSnapshot(ctx, conn, d, projectID, clusterName, cloudBackup.(bool), timeout); err != nil {
+			return diag.FromErr(fmt.Errorf(errorClusterUpdate, clusterName, err))
+		}
+	}
+
 	/*
 		Check if advaced configuration option has a changes to update it
 	*/
@@ -963,6 +971,21 @@ func didErrOnPausedCluster(err error) bool {
 	return errors.As(err, &target) && target.ErrorCode == "CANNOT_UPDATE_PAUSED_CLUSTER"
 }
 
+// clusterPreChangeSnapshotAttributes are the attributes whose changes can't be undone without restoring the cluster,
+// so they trigger the pre-change snapshot.
+var clusterPreChangeSnapshotAttributes = []string{
+	"cluster_type",
+	"disk_size_gb",
+	"encryption_at_rest_provider",
+	"mongo_db_major_version",
+	"num_shards",
+	"provider_instance_size_name",
+	"provider_name",
+	"provider_region_name",
+	"replication_factor",
+	"replication_specs",
+}
+
 func resourceMongoDBAtlasClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Get client connection.
 	conn := meta.(*MongoDBClient).Atlas
@@ -1444,8 +1467,34 @@ func resourceClusterCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m
 	} else if willProviderChange {
 		err = d.ForceNew("provider_name")
 	}
+	if err != nil {
+		return err
+	}
 
-	return err
+	return clusterPitEnabledComplianceCustomizeDiff(ctx, d, meta)
+}
+
+// clusterPitEnabledComplianceCustomizeDiff denies disabling pit_enabled on a cluster of a project whose backup
+// compliance policy requires Continuous Cloud Backup, Atlas rejects the change once the apply has started.
+func clusterPitEnabledComplianceCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("pit_enabled") || !d.NewValueKnown("pit_enabled") {
+		return nil
+	}
+	if oldPitEnabled, newPitEnabled := d.GetChange("pit_enabled"); !oldPitEnabled.(bool) || newPitEnabled.(bool) {
+		return nil
+	}
+
+	projectID := d.Get("project_id").(string)
+	compliancePolicy, err := getActiveBackupCompliancePolicy(ctx, meta.(*MongoDBClient).Atlas, projectID)
+	if err != nil {
+		log.Printf("[WARN] unable to get the backup compliance policy of project (%s), skipping `pit_enabled` check: %s", projectID, err)
+		return nil
+	}
+	if compliancePolicy == nil || !pointy.BoolValue(compliancePolicy.PitEnabled, false) {
+		return nil
+	}
+
+	return fmt.Errorf("`pit_enabled` can't be disabled on cluster (%s), the Backup Compliance Policy of project (%s) requires Continuous Cloud Backup", d.Get("name"), projectID)
 }
 
 func formatMongoDBMajorVersion(val interface{}) string {
@@ -1460,11 +1509,12 @@ func flattenConnectionStrings(connectionStrings *matlas.ConnectionStrings) []map
 	connections := make([]map[string]interface{}, 0)
 
 	connections = append(connections, map[string]interface{}{
-		"standard":         connectionStrings.Standard,
-		"standard_srv":     connectionStrings.StandardSrv,
-		"private":          connectionStrings.Private,
-		"private_srv":      connectionStrings.PrivateSrv,
-		"private_endpoint": flattenPrivateEndpoint(connectionStrings.PrivateEndpoint),
+		"standard":                   connectionStrings.Standard,
+		"standard_srv":               connectionStrings.StandardSrv,
+		"private":                    connectionStrings.Private,
+		"private_srv":                connectionStrings.PrivateSrv,
+		"private_endpoint":           flattenPrivateEndpoint(connectionStrings.PrivateEndpoint),
+		"private_endpoint_by_region": flattenPrivateEndpointByRegion(connectionStrings.PrivateEndpoint),
 	})
 
 	return connections
@@ -1484,6 +1534,42 @@ func flattenPrivateEndpoint(privateEndpoints []matlas.PrivateEndpoint) []map[str
 	return endpoints
 }
 
+// flattenPrivateEndpointByRegion groups the private endpoint connection strings by the cloud provider and region of
+// their endpoints. With the regional mode for private endpoints, each region has its own connection strings.
+func flattenPrivateEndpointByRegion(privateEndpoints []matlas.PrivateEndpoint) []map[string]interface{} {
+	regions := make([]map[string]interface{}, 0)
+	regionIndexes := make(map[string]int)
+	for i := range privateEndpoints {
+		for _, endpoint := range privateEndpoints[i].Endpoints {
+			// endpoints of a region are only grouped when they share the connection strings, without regional mode
+			// Atlas returns different connection strings for each endpoint of the same region
+			key := strings.Join([]string{
+				endpoint.ProviderName,
+				normalizeRegionName(endpoint.Region),
+				privateEndpoints[i].ConnectionString,
+				privateEndpoints[i].SRVConnectionString,
+				privateEndpoints[i].SRVShardOptimizedConnectionString,
+			}, "/")
+			if index, ok := regionIndexes[key]; ok {
+				regions[index]["endpoint_ids"] = append(regions[index]["endpoint_ids"].([]string), endpoint.EndpointID)
+				continue
+			}
+
+			regionIndexes[key] = len(regions)
+			regions = append(regions, map[string]interface{}{
+				"provider_name":                         endpoint.ProviderName,
+				"region":                                endpoint.Region,
+				"connection_string":                     privateEndpoints[i].ConnectionString,
+				"srv_connection_string":                 privateEndpoints[i].SRVConnectionString,
+				"srv_shard_optimized_connection_string": privateEndpoints[i].SRVShardOptimizedConnectionString,
+				"type":                                  privateEndpoints[i].Type,
+				"endpoint_ids":                          []string{endpoint.EndpointID},
+			})
+		}
+	}
+	return regions
+}
+
 func flattenEndpoints(listEndpoints []matlas.Endpoint) []map[string]interface{} {
 	endpoints := make([]map[string]interface{}, 0)
 	for _, endpoint := range listEndpoints {
@@ -1580,6 +1666,49 @@ func clusterConnectionStringsSchema() *schema.Schema {
 						},
 					},
 				},
+				"private_endpoint_by_region": privateEndpointByRegionSchema(),
+			},
+		},
+	}
+}
+
+func privateEndpointByRegionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"provider_name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"region": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"connection_string": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"srv_connection_string": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"srv_shard_optimized_connection_string": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"type": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"endpoint_ids": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
 			},
 		},
 	}
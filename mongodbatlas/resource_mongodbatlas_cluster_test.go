@@ -66,6 +66,40 @@ func TestAccClusterRSCluster_basicAWS_simple(t *testing.T) {
 	})
 }
 
+func TestAccClusterRSCluster_preChangeSnapshot(t *testing.T) {
+	var (
+		cluster      matlas.Cluster
+		resourceName = "mongodbatlas_cluster.test"
+		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		projectName  = acctest.RandomWithPrefix("test-acc")
+		name         = fmt.Sprintf("test-acc-%s", acctest.RandString(10))
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasClusterConfigPreChangeSnapshot(orgID, projectName, name, "M10"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasClusterExists(resourceName, &cluster),
+					resource.TestCheckResourceAttr(resourceName, "pre_change_snapshot.0.retention_in_days", "3"),
+					resource.TestCheckResourceAttr(resourceName, "pre_change_snapshot.0.last_snapshot_id", ""),
+				),
+			},
+			{
+				Config: testAccMongoDBAtlasClusterConfigPreChangeSnapshot(orgID, projectName, name, "M20"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasClusterExists(resourceName, &cluster),
+					resource.TestCheckResourceAttr(resourceName, "provider_instance_size_name", "M20"),
+					resource.TestCheckResourceAttrSet(resourceName, "pre_change_snapshot.0.last_snapshot_id"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccClusterRSCluster_basicAWS_instanceScale(t *testing.T) {
 	var (
 		cluster      matlas.Cluster
@@ -1515,6 +1549,29 @@ func testAccMongoDBAtlasClusterConfigAWS(orgID, projectName, name string, backup
 	`, orgID, projectName, name, backupEnabled, autoDiskGBEnabled)
 }
 
+func testAccMongoDBAtlasClusterConfigPreChangeSnapshot(orgID, projectName, name, instanceSize string) string {
+	return fmt.Sprintf(`
+resource "mongodbatlas_project" "cluster_project" {
+	name   = %[2]q
+	org_id = %[1]q
+}
+resource "mongodbatlas_cluster" "test" {
+  project_id   = mongodbatlas_project.cluster_project.id
+  name         = %[3]q
+  cluster_type = "REPLICASET"
+  cloud_backup = true
+
+  pre_change_snapshot {
+    retention_in_days = 3
+  }
+
+  provider_name               = "AWS"
+  provider_region_name        = "US_EAST_1"
+  provider_instance_size_name = %[4]q
+}
+	`, orgID, projectName, name, instanceSize)
+}
+
 func testAccMongoDBAtlasClusterConfigAWSNVMEInstance(orgID, projectName, name, instanceName string) string {
 	return fmt.Sprintf(`
 		resource "mongodbatlas_project" "cluster_project" {
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"net"
 	"net/http"
 	"reflect"
 	"strings"
@@ -25,6 +26,10 @@ const (
 	errorContainerUpdate  = "error updating MongoDB Network Peering Container (%s): %s"
 )
 
+// privateCIDRBlocks are the address ranges reserved for private networks by RFC 1918, Atlas requires the container
+// CIDR blocks to be within them.
+var privateCIDRBlocks = []string{"10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16"}
+
 func resourceMongoDBAtlasNetworkContainer() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceMongoDBAtlasNetworkContainerCreate,
@@ -34,6 +39,7 @@ func resourceMongoDBAtlasNetworkContainer() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceMongoDBAtlasNetworkContainerImportState,
 		},
+		CustomizeDiff: resourceMongoDBAtlasNetworkContainerCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"project_id": {
 				Type:     schema.TypeString,
@@ -95,6 +101,10 @@ func resourceMongoDBAtlasNetworkContainer() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"adopt_existing": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 		},
 	}
 }
@@ -135,6 +145,22 @@ func resourceMongoDBAtlasNetworkContainerCreate(ctx context.Context, d *schema.R
 		}
 	}
 
+	if d.Get("adopt_existing").(bool) {
+		container, err := adoptNetworkContainer(ctx, conn, projectID, containerRequest)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf(errorContainterCreate, err))
+		}
+
+		if container != nil {
+			d.SetId(encodeStateID(map[string]string{
+				"project_id":   projectID,
+				"container_id": container.ID,
+			}))
+
+			return resourceMongoDBAtlasNetworkContainerRead(ctx, d, meta)
+		}
+	}
+
 	container, _, err := conn.Containers.Create(ctx, projectID, containerRequest)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf(errorContainterCreate, err))
@@ -255,6 +281,20 @@ func resourceMongoDBAtlasNetworkContainerDelete(ctx context.Context, d *schema.R
 	// Get client connection.
 	conn := meta.(*MongoDBClient).Atlas
 
+	// an adopted container usually still has the clusters that created it, Atlas can't delete it until they are deleted
+	if d.Get("adopt_existing").(bool) {
+		ids := decodeStateID(d.Id())
+		container, _, err := conn.Containers.Get(ctx, ids["project_id"], ids["container_id"])
+		if err == nil && container.Provisioned != nil && *container.Provisioned {
+			return diag.Diagnostics{{
+				Severity: diag.Warning,
+				Summary:  "Network container not deleted",
+				Detail: fmt.Sprintf("The adopted network container (%s) still has clusters, so it was removed from the Terraform state but not deleted in Atlas.",
+					ids["container_id"]),
+			}}
+		}
+	}
+
 	stateConf := &retry.StateChangeConf{
 		Pending:    []string{"provisioned_container"},
 		Target:     []string{"deleted"},
@@ -313,6 +353,226 @@ func resourceMongoDBAtlasNetworkContainerImportState(ctx context.Context, d *sch
 	return []*schema.ResourceData{d}, nil
 }
 
+// adoptNetworkContainer returns the existing container of the cloud provider region of containerRequest, like the one
+// that Atlas creates with the first cluster of a region, or nil if there is none. The CIDR block of the container is
+// updated to the requested one if the container has no clusters yet.
+func adoptNetworkContainer(ctx context.Context, conn *matlas.Client, projectID string, containerRequest *matlas.Container) (*matlas.Container, error) {
+	containers, _, err := conn.Containers.ListAll(ctx, projectID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	region := containerRequest.RegionName
+	if containerRequest.ProviderName == "AZURE" {
+		region = containerRequest.Region
+	}
+
+	container := findNetworkContainer(containers, containerRequest.ProviderName, region)
+	if container == nil || container.AtlasCIDRBlock == containerRequest.AtlasCIDRBlock {
+		return container, nil
+	}
+
+	if container.Provisioned != nil && *container.Provisioned {
+		return nil, fmt.Errorf("the existing %s network container (%s) already has clusters in its CIDR block %s and can't be changed to %s, set `atlas_cidr_block` to %s to adopt it",
+			container.ProviderName, container.ID, container.AtlasCIDRBlock, containerRequest.AtlasCIDRBlock, container.AtlasCIDRBlock)
+	}
+
+	log.Printf("[INFO] changing the CIDR block of the adopted network container (%s) from %s to %s", container.ID, container.AtlasCIDRBlock, containerRequest.AtlasCIDRBlock)
+
+	container, _, err = conn.Containers.Update(ctx, projectID, container.ID, &matlas.Container{
+		AtlasCIDRBlock: containerRequest.AtlasCIDRBlock,
+		ProviderName:   containerRequest.ProviderName,
+	})
+
+	return container, err
+}
+
+// findNetworkContainer returns the container of the cloud provider region, the Atlas and the cloud provider names of
+// the region are both accepted. GCP projects have a single container for all the regions, which matches any region
+// unless it's limited to other `regions`.
+func findNetworkContainer(containers []matlas.Container, providerName, region string) *matlas.Container {
+	for i := range containers {
+		container := &containers[i]
+		if container.ProviderName != providerName {
+			continue
+		}
+
+		switch providerName {
+		case "GCP":
+			if region == "" || len(container.Regions) == 0 {
+				return container
+			}
+			for _, containerRegion := range container.Regions {
+				if normalizeRegionName(containerRegion) == normalizeRegionName(region) {
+					return container
+				}
+			}
+		case "AZURE":
+			if normalizeRegionName(container.Region) == normalizeRegionName(region) {
+				return container
+			}
+		default:
+			if normalizeRegionName(container.RegionName) == normalizeRegionName(region) {
+				return container
+			}
+		}
+	}
+
+	return nil
+}
+
+// resourceMongoDBAtlasNetworkContainerCustomizeDiff checks that atlas_cidr_block is allowed for the cloud provider and
+// that it doesn't overlap the other containers of the project or the peered VPCs of the container.
+func resourceMongoDBAtlasNetworkContainerCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("atlas_cidr_block") || !d.NewValueKnown("provider_name") || !d.NewValueKnown("regions") {
+		return nil
+	}
+
+	cidrBlock := d.Get("atlas_cidr_block").(string)
+	regional := len(d.Get("regions").([]interface{})) > 0
+	if err := validateContainerCIDRBlock(d.Get("provider_name").(string), cidrBlock, regional); err != nil {
+		return err
+	}
+
+	if (d.Id() != "" && !d.HasChange("atlas_cidr_block")) || !d.NewValueKnown("project_id") {
+		return nil
+	}
+
+	conn := meta.(*MongoDBClient).Atlas
+	projectID := d.Get("project_id").(string)
+	containerID := decodeStateID(d.Id())["container_id"]
+
+	// the project may not exist yet, the checks are skipped if the containers or the peerings can't be read
+	containers, _, err := conn.Containers.ListAll(ctx, projectID, nil)
+	if err != nil {
+		log.Printf("[WARN] unable to list the network containers of project (%s), skipping `atlas_cidr_block` overlap check: %s", projectID, err)
+		return nil
+	}
+
+	// the container that will be adopted is the one being planned
+	providerName := d.Get("provider_name").(string)
+	if containerID == "" && d.Get("adopt_existing").(bool) {
+		region := d.Get("region_name").(string)
+		if providerName == "AZURE" {
+			region = d.Get("region").(string)
+		}
+		if container := findNetworkContainer(containers, providerName, region); container != nil {
+			containerID = container.ID
+		}
+	}
+
+	// only the existing containers are checked, the containers created in the same apply aren't known yet
+	if container := findOverlappingNetworkContainer(containers, providerName, cidrBlock, containerID); container != nil {
+		return fmt.Errorf("`atlas_cidr_block` %s overlaps with %s of the %s network container (%s) of project (%s)",
+			cidrBlock, container.AtlasCIDRBlock, container.ProviderName, container.ID, projectID)
+	}
+
+	if containerID == "" {
+		return nil
+	}
+
+	peers, _, err := conn.Peers.List(ctx, projectID, &matlas.ContainersListOptions{ProviderName: providerName})
+	if err != nil {
+		log.Printf("[WARN] unable to list the network peerings of project (%s), skipping `atlas_cidr_block` overlap check: %s", projectID, err)
+		return nil
+	}
+
+	for i := range peers {
+		if peers[i].ContainerID == containerID && cidrBlocksOverlap(cidrBlock, peers[i].RouteTableCIDRBlock) {
+			return fmt.Errorf("`atlas_cidr_block` %s overlaps with the CIDR block %s of the peered VPC of network peering (%s)",
+				cidrBlock, peers[i].RouteTableCIDRBlock, peers[i].ID)
+		}
+	}
+
+	return nil
+}
+
+// validateContainerCIDRBlock returns an error if cidrBlock isn't an RFC 1918 network with a prefix length allowed
+// by Atlas for the cloud provider. regional is true for GCP containers with `regions`.
+func validateContainerCIDRBlock(providerName, cidrBlock string, regional bool) error {
+	ip, ipNet, err := net.ParseCIDR(cidrBlock)
+	if err != nil || ip.To4() == nil {
+		return fmt.Errorf("`atlas_cidr_block` %q isn't a valid IPv4 CIDR block", cidrBlock)
+	}
+
+	if ipNet.String() != cidrBlock {
+		return fmt.Errorf("`atlas_cidr_block` %q isn't the network address of the CIDR block, use %s", cidrBlock, ipNet)
+	}
+
+	if !isPrivateCIDRBlock(cidrBlock) {
+		return fmt.Errorf("`atlas_cidr_block` %s must be within the private ranges %s reserved by RFC 1918", cidrBlock, strings.Join(privateCIDRBlocks, ", "))
+	}
+
+	prefixLength, _ := ipNet.Mask.Size()
+	minPrefixLength, maxPrefixLength := containerCIDRPrefixLengths(providerName, regional)
+	if prefixLength < minPrefixLength || prefixLength > maxPrefixLength {
+		return fmt.Errorf("`atlas_cidr_block` %s must have a prefix length between /%d and /%d for %s containers",
+			cidrBlock, minPrefixLength, maxPrefixLength, providerName)
+	}
+
+	return nil
+}
+
+// findOverlappingNetworkContainer returns the first container of the cloud provider, other than the one with
+// containerID, whose CIDR block overlaps cidrBlock. Atlas only rejects overlapping containers of the same provider.
+func findOverlappingNetworkContainer(containers []matlas.Container, providerName, cidrBlock, containerID string) *matlas.Container {
+	for i := range containers {
+		if containers[i].ID == containerID || containers[i].ProviderName != providerName {
+			continue
+		}
+		if cidrBlocksOverlap(cidrBlock, containers[i].AtlasCIDRBlock) {
+			return &containers[i]
+		}
+	}
+	return nil
+}
+
+// containerCIDRPrefixLengths returns the range of prefix lengths that Atlas allows for the container CIDR blocks
+// of the cloud provider. GCP containers need at least a /18 block, or a /21 block if they are limited to `regions`.
+func containerCIDRPrefixLengths(providerName string, regional bool) (minPrefixLength, maxPrefixLength int) {
+	if providerName == "GCP" && regional {
+		return 8, 21
+	}
+	if providerName == "GCP" {
+		return 8, 18
+	}
+
+	return 21, 24
+}
+
+func isPrivateCIDRBlock(cidrBlock string) bool {
+	_, ipNet, err := net.ParseCIDR(cidrBlock)
+	if err != nil {
+		return false
+	}
+
+	prefixLength, _ := ipNet.Mask.Size()
+	for _, block := range privateCIDRBlocks {
+		_, privateNet, _ := net.ParseCIDR(block)
+		privatePrefixLength, _ := privateNet.Mask.Size()
+		if privateNet.Contains(ipNet.IP) && prefixLength >= privatePrefixLength {
+			return true
+		}
+	}
+
+	return false
+}
+
+// cidrBlocksOverlap returns true if the CIDR blocks share any address, invalid or empty blocks never overlap.
+func cidrBlocksOverlap(cidrBlock1, cidrBlock2 string) bool {
+	_, ipNet1, err := net.ParseCIDR(cidrBlock1)
+	if err != nil {
+		return false
+	}
+
+	_, ipNet2, err := net.ParseCIDR(cidrBlock2)
+	if err != nil {
+		return false
+	}
+
+	return ipNet1.Contains(ipNet2.IP) || ipNet2.Contains(ipNet1.IP)
+}
+
 func resourceNetworkContainerRefreshFunc(ctx context.Context, d *schema.ResourceData, client *matlas.Client) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		ids := decodeStateID(d.Id())
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"reflect"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -216,6 +218,248 @@ func TestAccNetworkRSNetworkContainer_importBasic(t *testing.T) {
 	})
 }
 
+func TestAccNetworkRSNetworkContainer_overlappingCIDRBlocks(t *testing.T) {
+	var (
+		dataSourceName = "data.mongodbatlas_network_container_free_cidr_blocks.test"
+		orgID          = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		projectName    = acctest.RandomWithPrefix("test-acc")
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasNetworkContainerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccMongoDBAtlasNetworkContainerConfigAWS(projectName, orgID, "10.0.0.0/16", "AWS", "US_EAST_1"),
+				ExpectError: regexp.MustCompile("must have a prefix length between /21 and /24"),
+			},
+			{
+				Config: testAccMongoDBAtlasNetworkContainerConfigFreeCIDRBlocks(projectName, orgID, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "prefix_length", "21"),
+					resource.TestCheckResourceAttr(dataSourceName, "cidr_blocks.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "cidr_blocks.0", "10.0.8.0/21"),
+					resource.TestCheckResourceAttr(dataSourceName, "cidr_blocks.1", "10.0.16.0/21"),
+				),
+			},
+			{
+				Config:      testAccMongoDBAtlasNetworkContainerConfigFreeCIDRBlocks(projectName, orgID, "10.0.4.0/22"),
+				ExpectError: regexp.MustCompile("overlaps with 10.0.0.0/21"),
+			},
+		},
+	})
+}
+
+func TestAccNetworkRSNetworkContainer_adoptExisting(t *testing.T) {
+	var (
+		resourceName   = "mongodbatlas_network_container.test"
+		dataSourceName = "data.mongodbatlas_network_container_by_region.test"
+		orgID          = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		projectName    = acctest.RandomWithPrefix("test-acc")
+		clusterName    = acctest.RandomWithPrefix("test-acc")
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasNetworkContainerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasNetworkContainerConfigAdoptExisting(projectName, orgID, clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "container_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "provisioned", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "container_id", dataSourceName, "container_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "atlas_cidr_block", dataSourceName, "atlas_cidr_block"),
+					resource.TestCheckResourceAttr(resourceName, "region_name", "US_EAST_1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAdvancedClusterRegionOfProvider(t *testing.T) {
+	clusters := []*matlas.AdvancedCluster{
+		{ID: "1"},
+		{
+			ID: "2",
+			ReplicationSpecs: []*matlas.AdvancedReplicationSpec{
+				{RegionConfigs: []*matlas.AdvancedRegionConfig{{ProviderName: "AZURE", RegionName: "US_EAST_2"}}},
+				{RegionConfigs: []*matlas.AdvancedRegionConfig{{ProviderName: "AWS", RegionName: "EU_WEST_1"}, {ProviderName: "AWS", RegionName: "US_EAST_1"}}},
+			},
+		},
+	}
+
+	cluster := findAdvancedClusterByID(clusters, "2")
+	if cluster == nil {
+		t.Fatal("expected to find cluster 2")
+	}
+	if region := advancedClusterRegionOfProvider(cluster, "AWS"); region != "EU_WEST_1" {
+		t.Errorf("expected the first AWS region EU_WEST_1, got %q", region)
+	}
+	if region := advancedClusterRegionOfProvider(cluster, "GCP"); region != "" {
+		t.Errorf("expected no GCP region, got %q", region)
+	}
+	if findAdvancedClusterByID(clusters, "3") != nil {
+		t.Error("expected no cluster with ID 3")
+	}
+}
+
+func TestFindNetworkContainer(t *testing.T) {
+	containers := []matlas.Container{
+		{ID: "aws-east", ProviderName: "AWS", RegionName: "US_EAST_1"},
+		{ID: "azure-east", ProviderName: "AZURE", Region: "US_EAST_2"},
+		{ID: "gcp", ProviderName: "GCP", Regions: []string{"US_EAST_4", "CENTRAL_US"}},
+	}
+
+	testCases := []struct {
+		name         string
+		providerName string
+		region       string
+		expected     string
+	}{
+		{name: "AWS Atlas region", providerName: "AWS", region: "US_EAST_1", expected: "aws-east"},
+		{name: "AWS cloud provider region", providerName: "AWS", region: "us-east-1", expected: "aws-east"},
+		{name: "AWS other region", providerName: "AWS", region: "US_WEST_2"},
+		{name: "Azure region", providerName: "AZURE", region: "US_EAST_2", expected: "azure-east"},
+		{name: "Azure region of other provider", providerName: "AZURE", region: "US_EAST_1"},
+		{name: "GCP any region", providerName: "GCP", expected: "gcp"},
+		{name: "GCP container region", providerName: "GCP", region: "CENTRAL_US", expected: "gcp"},
+		{name: "GCP region out of the container", providerName: "GCP", region: "WESTERN_EUROPE"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			container := findNetworkContainer(containers, tc.providerName, tc.region)
+			if tc.expected == "" {
+				if container != nil {
+					t.Errorf("expected no container, got %s", container.ID)
+				}
+				return
+			}
+
+			if container == nil || container.ID != tc.expected {
+				t.Errorf("expected container %s, got %v", tc.expected, container)
+			}
+		})
+	}
+}
+
+func TestFindOverlappingNetworkContainer(t *testing.T) {
+	containers := []matlas.Container{
+		{ID: "aws", ProviderName: "AWS", AtlasCIDRBlock: "192.168.0.0/21"},
+		{ID: "gcp", ProviderName: "GCP", AtlasCIDRBlock: "10.0.0.0/18"},
+	}
+
+	testCases := []struct {
+		name         string
+		providerName string
+		cidrBlock    string
+		containerID  string
+		expected     string
+	}{
+		{name: "same provider", providerName: "AWS", cidrBlock: "192.168.0.0/24", expected: "aws"},
+		{name: "other provider", providerName: "AWS", cidrBlock: "10.0.8.0/21"},
+		{name: "same container", providerName: "AWS", cidrBlock: "192.168.0.0/24", containerID: "aws"},
+		{name: "no overlap", providerName: "GCP", cidrBlock: "10.1.0.0/18"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			container := findOverlappingNetworkContainer(containers, tc.providerName, tc.cidrBlock, tc.containerID)
+			id := ""
+			if container != nil {
+				id = container.ID
+			}
+			if id != tc.expected {
+				t.Errorf("expected overlapping container %q, got %q", tc.expected, id)
+			}
+		})
+	}
+}
+
+func TestValidateContainerCIDRBlock(t *testing.T) {
+	testCases := []struct {
+		name         string
+		providerName string
+		cidrBlock    string
+		regional     bool
+		expectError  bool
+	}{
+		{name: "AWS /21", providerName: "AWS", cidrBlock: "10.8.0.0/21"},
+		{name: "Azure /24", providerName: "AZURE", cidrBlock: "192.168.1.0/24"},
+		{name: "GCP /18", providerName: "GCP", cidrBlock: "172.16.0.0/18"},
+		{name: "GCP regional /21", providerName: "GCP", cidrBlock: "10.8.0.0/21", regional: true},
+		{name: "GCP /21", providerName: "GCP", cidrBlock: "10.8.0.0/21", expectError: true},
+		{name: "AWS too large", providerName: "AWS", cidrBlock: "10.8.0.0/16", expectError: true},
+		{name: "AWS too small", providerName: "AWS", cidrBlock: "10.8.0.0/25", expectError: true},
+		{name: "not private", providerName: "AWS", cidrBlock: "100.64.0.0/21", expectError: true},
+		{name: "larger than private range", providerName: "GCP", cidrBlock: "172.0.0.0/8", expectError: true},
+		{name: "not network address", providerName: "AWS", cidrBlock: "10.8.1.0/21", expectError: true},
+		{name: "invalid", providerName: "AWS", cidrBlock: "10.8.0.0", expectError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateContainerCIDRBlock(tc.providerName, tc.cidrBlock, tc.regional)
+			if tc.expectError != (err != nil) {
+				t.Errorf("validateContainerCIDRBlock(%q, %q, %t) returned error %v, expected error: %t", tc.providerName, tc.cidrBlock, tc.regional, err, tc.expectError)
+			}
+		})
+	}
+}
+
+func TestFindFreeCIDRBlocks(t *testing.T) {
+	testCases := []struct {
+		name           string
+		usedCIDRBlocks []string
+		prefixLength   int
+		count          int
+		expected       []string
+	}{
+		{
+			name:         "no used blocks",
+			prefixLength: 21,
+			count:        2,
+			expected:     []string{"10.0.0.0/21", "10.0.8.0/21"},
+		},
+		{
+			name:           "skips overlapping blocks",
+			usedCIDRBlocks: []string{"10.0.0.0/24", "10.0.8.0/21", "10.0.20.0/22"},
+			prefixLength:   21,
+			count:          2,
+			expected:       []string{"10.0.24.0/21", "10.0.32.0/21"},
+		},
+		{
+			name:           "next private range",
+			usedCIDRBlocks: []string{"10.0.0.0/8"},
+			prefixLength:   18,
+			count:          1,
+			expected:       []string{"172.16.0.0/18"},
+		},
+		{
+			name:           "GCP block larger than the other private ranges",
+			usedCIDRBlocks: []string{"10.0.0.0/8"},
+			prefixLength:   10,
+			count:          1,
+		},
+		{
+			name:           "no free block",
+			usedCIDRBlocks: privateCIDRBlocks,
+			prefixLength:   24,
+			count:          1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := findFreeCIDRBlocks(tc.prefixLength, tc.count, tc.usedCIDRBlocks); !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("findFreeCIDRBlocks() = %v, expected %v", got, tc.expected)
+			}
+		})
+	}
+}
 func testAccCheckMongoDBAtlasNetworkContainerImportStateIDFunc(resourceName string) resource.ImportStateIdFunc {
 	return func(s *terraform.State) (string, error) {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -363,3 +607,68 @@ func testAccMongoDBAtlasNetworkContainerConfigGCPWithRegions(projectName, orgID,
 		}
 	`, projectName, orgID, cidrBlock, providerName)
 }
+
+func testAccMongoDBAtlasNetworkContainerConfigFreeCIDRBlocks(projectName, orgID, overlappingCIDRBlock string) string {
+	config := fmt.Sprintf(`
+		resource "mongodbatlas_project" "test" {
+			name   = %[1]q
+			org_id = %[2]q
+		}
+
+		resource "mongodbatlas_network_container" "test" {
+			project_id       = mongodbatlas_project.test.id
+			atlas_cidr_block = "10.0.0.0/21"
+			provider_name    = "AWS"
+			region_name      = "US_EAST_1"
+		}
+
+		data "mongodbatlas_network_container_free_cidr_blocks" "test" {
+			project_id       = mongodbatlas_network_container.test.project_id
+			number_of_blocks = 2
+		}
+	`, projectName, orgID)
+
+	if overlappingCIDRBlock == "" {
+		return config
+	}
+
+	return config + fmt.Sprintf(`
+		resource "mongodbatlas_network_container" "overlapping" {
+			project_id       = mongodbatlas_project.test.id
+			atlas_cidr_block = %[1]q
+			provider_name    = "AWS"
+			region_name      = "US_WEST_2"
+		}
+	`, overlappingCIDRBlock)
+}
+
+func testAccMongoDBAtlasNetworkContainerConfigAdoptExisting(projectName, orgID, clusterName string) string {
+	return fmt.Sprintf(`
+		resource "mongodbatlas_project" "test" {
+			name   = %[1]q
+			org_id = %[2]q
+		}
+
+		resource "mongodbatlas_cluster" "test" {
+			project_id                  = mongodbatlas_project.test.id
+			name                        = %[3]q
+			provider_name               = "AWS"
+			provider_region_name        = "US_EAST_1"
+			provider_instance_size_name = "M10"
+		}
+
+		data "mongodbatlas_network_container_by_region" "test" {
+			project_id    = mongodbatlas_cluster.test.project_id
+			provider_name = "AWS"
+			cluster_id    = mongodbatlas_cluster.test.cluster_id
+		}
+
+		resource "mongodbatlas_network_container" "test" {
+			project_id       = mongodbatlas_project.test.id
+			atlas_cidr_block = data.mongodbatlas_network_container_by_region.test.atlas_cidr_block
+			provider_name    = "AWS"
+			region_name      = "US_EAST_1"
+			adopt_existing   = true
+		}
+	`, projectName, orgID, clusterName)
+}
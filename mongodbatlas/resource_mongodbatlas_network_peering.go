@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"net"
 	"net/http"
 	"reflect"
 	"strings"
@@ -33,6 +34,7 @@ func resourceMongoDBAtlasNetworkPeering() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceMongoDBAtlasNetworkPeeringImportState,
 		},
+		CustomizeDiff: resourceMongoDBAtlasNetworkPeeringCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"project_id": {
 				Type:     schema.TypeString,
@@ -149,6 +151,19 @@ func resourceMongoDBAtlasNetworkPeering() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"wait_for_available": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"recreate_when_failed": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Hour),
 		},
 	}
 }
@@ -239,11 +254,26 @@ func resourceMongoDBAtlasNetworkPeeringCreate(ctx context.Context, d *schema.Res
 		return diag.FromErr(fmt.Errorf(errorPeersCreate, err))
 	}
 
+	// the ID is set before waiting so the peering connection is kept in the state, as tainted, if it fails
+	d.SetId(encodeStateID(map[string]string{
+		"project_id":    projectID,
+		"peer_id":       peer.ID,
+		"provider_name": providerName,
+	}))
+
+	pending := []string{"INITIATING", "FINALIZING", "ADDING_PEER", "WAITING_FOR_USER"}
+	target := []string{"AVAILABLE", "PENDING_ACCEPTANCE"}
+	// when the accepter side is automated, the peering connection is accepted during the apply
+	if d.Get("wait_for_available").(bool) {
+		pending = append(pending, "PENDING_ACCEPTANCE")
+		target = []string{"AVAILABLE"}
+	}
+
 	stateConf := &retry.StateChangeConf{
-		Pending:    []string{"INITIATING", "FINALIZING", "ADDING_PEER", "WAITING_FOR_USER"},
-		Target:     []string{"AVAILABLE", "PENDING_ACCEPTANCE"},
-		Refresh:    resourceNetworkPeeringRefreshFunc(ctx, peer.ID, projectID, peerRequest.ContainerID, conn),
-		Timeout:    1 * time.Hour,
+		Pending:    pending,
+		Target:     target,
+		Refresh:    resourceNetworkPeeringCreateRefreshFunc(ctx, peer.ID, projectID, peerRequest.ContainerID, conn),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
 		MinTimeout: 10 * time.Second,
 		Delay:      30 * time.Second,
 	}
@@ -251,15 +281,14 @@ func resourceMongoDBAtlasNetworkPeeringCreate(ctx context.Context, d *schema.Res
 	// Wait, catching any errors
 	_, err = stateConf.WaitForStateContext(ctx)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf(errorPeersCreate, err))
+		diags := resourceMongoDBAtlasNetworkPeeringRead(ctx, d, meta)
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf(errorPeersCreate, err),
+			Detail:   "The network peering connection is kept in the state as tainted and will be replaced on the next apply.",
+		})
 	}
 
-	d.SetId(encodeStateID(map[string]string{
-		"project_id":    projectID,
-		"peer_id":       peer.ID,
-		"provider_name": providerName,
-	}))
-
 	return resourceMongoDBAtlasNetworkPeeringRead(ctx, d, meta)
 }
 
@@ -387,6 +416,15 @@ func resourceMongoDBAtlasNetworkPeeringRead(ctx context.Context, d *schema.Resou
 		return diag.FromErr(fmt.Errorf("error setting `atlas_vpc_name` for Network Peering Connection (%s): %s", peerID, err))
 	}
 
+	if reason := networkPeeringFailureReason(peer); reason != "" {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Network Peering Connection (%s) is in %s", peerID, reason),
+			Detail: fmt.Sprintf("The peer may have been deleted or rejected in %s. Set `recreate_when_failed` to plan the recreation of the "+
+				"peering connection, or replace it with `terraform apply -replace`.", providerName),
+		}}
+	}
+
 	return nil
 }
 
@@ -397,6 +435,11 @@ func resourceMongoDBAtlasNetworkPeeringUpdate(ctx context.Context, d *schema.Res
 	projectID := ids["project_id"]
 	peerID := ids["peer_id"]
 
+	// wait_for_available and recreate_when_failed are only used by the provider
+	if !d.HasChangesExcept("wait_for_available", "recreate_when_failed") {
+		return resourceMongoDBAtlasNetworkPeeringRead(ctx, d, meta)
+	}
+
 	// All the request to update the peer require the ProviderName and ContainerID attribute.
 	peer := &matlas.Peer{
 		ProviderName: ids["provider_name"],
@@ -519,6 +562,14 @@ func resourceMongoDBAtlasNetworkPeeringImportState(ctx context.Context, d *schem
 		log.Printf("[WARN] Error setting provider_name for (%s): %s", peerID, err)
 	}
 
+	if err := d.Set("wait_for_available", false); err != nil {
+		log.Printf("[WARN] Error setting wait_for_available for (%s): %s", peerID, err)
+	}
+
+	if err := d.Set("recreate_when_failed", false); err != nil {
+		log.Printf("[WARN] Error setting recreate_when_failed for (%s): %s", peerID, err)
+	}
+
 	d.SetId(encodeStateID(map[string]string{
 		"project_id":    projectID,
 		"peer_id":       peer.ID,
@@ -528,6 +579,104 @@ func resourceMongoDBAtlasNetworkPeeringImportState(ctx context.Context, d *schem
 	return []*schema.ResourceData{d}, nil
 }
 
+// resourceMongoDBAtlasNetworkPeeringCustomizeDiff plans the recreation of a failed peering connection when
+// recreate_when_failed is set, and checks that the CIDR block of the peered AWS VPC is valid and that it doesn't
+// overlap the CIDR block of the Atlas network container.
+func resourceMongoDBAtlasNetworkPeeringCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && d.Get("recreate_when_failed").(bool) {
+		peer := &matlas.Peer{
+			Status:         d.Get("status").(string),
+			StatusName:     d.Get("status_name").(string),
+			ErrorState:     d.Get("error_state").(string),
+			ErrorStateName: d.Get("error_state_name").(string),
+		}
+		if networkPeeringFailureReason(peer) != "" {
+			if err := d.SetNewComputed("status_name"); err != nil {
+				return err
+			}
+			if err := d.ForceNew("status_name"); err != nil {
+				return err
+			}
+		}
+	}
+
+	if !d.NewValueKnown("route_table_cidr_block") || !d.NewValueKnown("provider_name") || d.Get("provider_name").(string) != "AWS" {
+		return nil
+	}
+
+	routeTableCIDRBlock := d.Get("route_table_cidr_block").(string)
+	if routeTableCIDRBlock == "" {
+		return nil
+	}
+
+	if _, ipNet, err := net.ParseCIDR(routeTableCIDRBlock); err != nil || ipNet.IP.To4() == nil {
+		return fmt.Errorf("`route_table_cidr_block` %q isn't a valid IPv4 CIDR block", routeTableCIDRBlock)
+	}
+
+	if (d.Id() != "" && !d.HasChange("route_table_cidr_block")) || !d.NewValueKnown("project_id") || !d.NewValueKnown("container_id") {
+		return nil
+	}
+
+	conn := meta.(*MongoDBClient).Atlas
+	projectID := d.Get("project_id").(string)
+	containerID := d.Get("container_id").(string)
+
+	container, _, err := conn.Containers.Get(ctx, projectID, containerID)
+	if err != nil {
+		log.Printf("[WARN] unable to get the network container (%s) of project (%s), skipping `route_table_cidr_block` overlap check: %s", containerID, projectID, err)
+		return nil
+	}
+
+	if cidrBlocksOverlap(routeTableCIDRBlock, container.AtlasCIDRBlock) {
+		return fmt.Errorf("`route_table_cidr_block` %s overlaps with the CIDR block %s of the network container (%s), the peered VPC and the Atlas VPC can't share addresses",
+			routeTableCIDRBlock, container.AtlasCIDRBlock, containerID)
+	}
+
+	return nil
+}
+
+// resourceNetworkPeeringCreateRefreshFunc stops waiting with an error, instead of an unexpected state, when the
+// peering connection fails.
+func resourceNetworkPeeringCreateRefreshFunc(ctx context.Context, peerID, projectID, containerID string, client *matlas.Client) retry.StateRefreshFunc {
+	refresh := resourceNetworkPeeringRefreshFunc(ctx, peerID, projectID, containerID, client)
+	return func() (interface{}, string, error) {
+		result, status, err := refresh()
+		if peer, ok := result.(*matlas.Peer); ok && err == nil {
+			if reason := networkPeeringFailureReason(peer); reason != "" {
+				return result, status, fmt.Errorf("the network peering connection is in %s", reason)
+			}
+		}
+		return result, status, err
+	}
+}
+
+// networkPeeringFailureReason describes why the peering connection is broken, e.g. because the peer was deleted or
+// rejected in the cloud provider, or returns an empty string if it isn't.
+func networkPeeringFailureReason(peer *matlas.Peer) string {
+	status := peer.Status
+	if peer.StatusName != "" {
+		status = peer.StatusName
+	}
+	errorState := peer.ErrorStateName
+	if errorState == "" {
+		errorState = peer.ErrorState
+	}
+
+	if status != "FAILED" && status != "TERMINATING" && errorState == "" {
+		return ""
+	}
+
+	reason := fmt.Sprintf("status %s", status)
+	if errorState != "" {
+		reason += fmt.Sprintf(" with error state %s", errorState)
+	}
+	if peer.ErrorMessage != "" {
+		reason += fmt.Sprintf(": %s", peer.ErrorMessage)
+	}
+
+	return reason
+}
+
 func resourceNetworkPeeringRefreshFunc(ctx context.Context, peerID, projectID, containerID string, client *matlas.Client) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		c, resp, err := client.Peers.Get(ctx, projectID, peerID)
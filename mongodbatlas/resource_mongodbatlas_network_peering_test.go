@@ -210,6 +210,48 @@ func testAccCheckMongoDBAtlasNetworkPeeringImportStateIDFunc(resourceName string
 	}
 }
 
+func TestNetworkPeeringFailureReason(t *testing.T) {
+	testCases := []struct {
+		name     string
+		peer     matlas.Peer
+		expected string
+	}{
+		{
+			name:     "available AWS peering",
+			peer:     matlas.Peer{StatusName: "AVAILABLE"},
+			expected: "",
+		},
+		{
+			name:     "pending acceptance",
+			peer:     matlas.Peer{StatusName: "PENDING_ACCEPTANCE"},
+			expected: "",
+		},
+		{
+			name:     "AWS peering rejected",
+			peer:     matlas.Peer{StatusName: "FAILED", ErrorStateName: "REJECTED"},
+			expected: "status FAILED with error state REJECTED",
+		},
+		{
+			name:     "AWS peering deleted",
+			peer:     matlas.Peer{StatusName: "TERMINATING"},
+			expected: "status TERMINATING",
+		},
+		{
+			name:     "Azure peering failed",
+			peer:     matlas.Peer{Status: "FAILED", ErrorState: "VNET_NOT_FOUND", ErrorMessage: "the VNet doesn't exist"},
+			expected: "status FAILED with error state VNET_NOT_FOUND: the VNet doesn't exist",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := networkPeeringFailureReason(&tc.peer); got != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
+
 func testAccCheckMongoDBAtlasNetworkPeeringExists(resourceName string, peer *matlas.Peer) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := testAccProviderSdkV2.Meta().(*MongoDBClient).Atlas
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	matlas "go.mongodb.org/atlas/mongodbatlas"
 )
 
 type permCtxKey string
@@ -108,7 +109,7 @@ func resourceMongoDBAtlasPrivateEndpointRegionalModeUpdate(ctx context.Context,
 	stateConf := &retry.StateChangeConf{
 		Pending:    []string{"REPEATING", "PENDING"},
 		Target:     []string{"IDLE", "DELETED"},
-		Refresh:    resourceClusterListAdvancedRefreshFunc(ctx, projectID, conn),
+		Refresh:    resourcePrivateEndpointRegionalModeRefreshFunc(ctx, projectID, enabled, conn),
 		Timeout:    d.Timeout(timeoutKey.(string)),
 		MinTimeout: 5 * time.Second,
 		Delay:      3 * time.Second,
@@ -122,6 +123,45 @@ func resourceMongoDBAtlasPrivateEndpointRegionalModeUpdate(ctx context.Context,
 	return nil
 }
 
+// resourcePrivateEndpointRegionalModeRefreshFunc waits until the clusters of the project are IDLE and, when the
+// regional mode is enabled, expose regionalized private endpoint connection strings, so that the connection strings
+// read after the update aren't stale.
+func resourcePrivateEndpointRegionalModeRefreshFunc(ctx context.Context, projectID string, enabled bool, client *matlas.Client) retry.StateRefreshFunc {
+	clusterListRefresh := resourceClusterListAdvancedRefreshFunc(ctx, projectID, client)
+
+	return func() (interface{}, string, error) {
+		result, state, err := clusterListRefresh()
+		if err != nil || state != "IDLE" || !enabled {
+			return result, state, err
+		}
+
+		clusters := result.(*matlas.AdvancedClustersResponse)
+		for _, cluster := range clusters.Results {
+			if cluster.ConnectionStrings != nil && !isRegionalizedPrivateEndpoints(cluster.ConnectionStrings.PrivateEndpoint) {
+				log.Printf("[DEBUG] waiting for the private endpoint connection strings of cluster %s to be regionalized", cluster.Name)
+				return cluster, "PENDING", nil
+			}
+		}
+
+		return clusters, "IDLE", nil
+	}
+}
+
+// isRegionalizedPrivateEndpoints returns true if each private endpoint connection string only serves the endpoints of
+// a single region, as Atlas does when the regional mode is enabled.
+func isRegionalizedPrivateEndpoints(privateEndpoints []matlas.PrivateEndpoint) bool {
+	for i := range privateEndpoints {
+		endpoints := privateEndpoints[i].Endpoints
+		for j := range endpoints {
+			if endpoints[j].ProviderName != endpoints[0].ProviderName || normalizeRegionName(endpoints[j].Region) != normalizeRegionName(endpoints[0].Region) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
 func resourceMongoDBAtlasPrivateEndpointRegionalModeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	if err := d.Set("enabled", false); err == nil {
 		resourceMongoDBAtlasPrivateEndpointRegionalModeUpdate(context.WithValue(ctx, regionalModeTimeoutCtxKey, schema.TimeoutDelete), d, meta)
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	matlas "go.mongodb.org/atlas/mongodbatlas"
 )
 
 func TestAccNetworkRSPrivateEndpointRegionalMode_conn(t *testing.T) {
@@ -103,6 +104,90 @@ func TestAccNetworkRSPrivateEndpointRegionalMode_basic(t *testing.T) {
 	})
 }
 
+func TestIsRegionalizedPrivateEndpoints(t *testing.T) {
+	testCases := []struct {
+		name             string
+		privateEndpoints []matlas.PrivateEndpoint
+		expected         bool
+	}{
+		{
+			name:     "no private endpoints",
+			expected: true,
+		},
+		{
+			name: "one connection string per region",
+			privateEndpoints: []matlas.PrivateEndpoint{
+				{Endpoints: []matlas.Endpoint{{EndpointID: "vpce-1", ProviderName: "AWS", Region: "us-east-1"}, {EndpointID: "vpce-2", ProviderName: "AWS", Region: "US_EAST_1"}}},
+				{Endpoints: []matlas.Endpoint{{EndpointID: "vpce-3", ProviderName: "AWS", Region: "us-west-2"}}},
+			},
+			expected: true,
+		},
+		{
+			name: "connection string shared by regions",
+			privateEndpoints: []matlas.PrivateEndpoint{
+				{Endpoints: []matlas.Endpoint{{EndpointID: "vpce-1", ProviderName: "AWS", Region: "us-east-1"}, {EndpointID: "vpce-3", ProviderName: "AWS", Region: "us-west-2"}}},
+			},
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := isRegionalizedPrivateEndpoints(tc.privateEndpoints); actual != tc.expected {
+				t.Errorf("expected %t, got %t", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestFlattenPrivateEndpointByRegion(t *testing.T) {
+	privateEndpoints := []matlas.PrivateEndpoint{
+		{
+			SRVConnectionString: "mongodb+srv://cluster-pl-0.mongodb.net",
+			Type:                "MONGOD",
+			Endpoints:           []matlas.Endpoint{{EndpointID: "vpce-1", ProviderName: "AWS", Region: "us-east-1"}},
+		},
+		{
+			SRVConnectionString: "mongodb+srv://cluster-pl-1.mongodb.net",
+			Type:                "MONGOD",
+			Endpoints:           []matlas.Endpoint{{EndpointID: "vpce-2", ProviderName: "AWS", Region: "us-west-2"}, {EndpointID: "vpce-3", ProviderName: "AWS", Region: "us-west-2"}},
+		},
+		{
+			SRVConnectionString: "mongodb+srv://cluster-pl-2.mongodb.net",
+			Type:                "MONGOD",
+			Endpoints:           []matlas.Endpoint{{EndpointID: "vpce-4", ProviderName: "AWS", Region: "US_EAST_1"}},
+		},
+		{
+			SRVConnectionString: "mongodb+srv://cluster-pl-1.mongodb.net",
+			Type:                "MONGOD",
+			Endpoints:           []matlas.Endpoint{{EndpointID: "vpce-5", ProviderName: "AWS", Region: "US_WEST_2"}},
+		},
+	}
+
+	regions := flattenPrivateEndpointByRegion(privateEndpoints)
+	if len(regions) != 3 {
+		t.Fatalf("expected 3 entries, got %d: %v", len(regions), regions)
+	}
+
+	expected := []struct {
+		region      string
+		srv         string
+		endpointIDs []string
+	}{
+		{region: "us-east-1", srv: "mongodb+srv://cluster-pl-0.mongodb.net", endpointIDs: []string{"vpce-1"}},
+		{region: "us-west-2", srv: "mongodb+srv://cluster-pl-1.mongodb.net", endpointIDs: []string{"vpce-2", "vpce-3", "vpce-5"}},
+		{region: "US_EAST_1", srv: "mongodb+srv://cluster-pl-2.mongodb.net", endpointIDs: []string{"vpce-4"}},
+	}
+	for i, e := range expected {
+		if regions[i]["region"] != e.region || regions[i]["srv_connection_string"] != e.srv {
+			t.Errorf("expected region %s with %s, got %v", e.region, e.srv, regions[i])
+		}
+		if fmt.Sprint(regions[i]["endpoint_ids"]) != fmt.Sprint(e.endpointIDs) {
+			t.Errorf("expected endpoints %v for region %s, got %v", e.endpointIDs, e.region, regions[i]["endpoint_ids"])
+		}
+	}
+}
+
 func testAccMongoDBAtlasPrivateEndpointRegionalModeClusterData(clusterResourceName, regionalModeResourceName, privateLinkResourceName string) string {
 	return fmt.Sprintf(`
 		data "mongodbatlas_cluster" %[1]q {
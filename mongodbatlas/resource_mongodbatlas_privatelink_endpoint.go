@@ -27,6 +27,7 @@ func resourceMongoDBAtlasPrivateLinkEndpoint() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceMongoDBAtlasPrivateLinkEndpointCreate,
 		ReadContext:   resourceMongoDBAtlasPrivateLinkEndpointRead,
+		UpdateContext: resourceMongoDBAtlasPrivateLinkEndpointUpdate,
 		DeleteContext: resourceMongoDBAtlasPrivateLinkEndpointDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceMongoDBAtlasPrivateLinkEndpointImportState,
@@ -48,6 +49,12 @@ func resourceMongoDBAtlasPrivateLinkEndpoint() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"retries_on_failure": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(0, 5),
+			},
 			"private_link_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -123,32 +130,47 @@ func resourceMongoDBAtlasPrivateLinkEndpointCreate(ctx context.Context, d *schem
 		Region:       region,
 	}
 
-	privateEndpointConn, _, err := conn.PrivateEndpoints.Create(ctx, projectID, request)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf(errorPrivateLinkEndpointsCreate, err))
-	}
+	retries := d.Get("retries_on_failure").(int)
+	for attempt := 0; ; attempt++ {
+		privateEndpointConn, _, err := conn.PrivateEndpoints.Create(ctx, projectID, request)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf(errorPrivateLinkEndpointsCreate, err))
+		}
 
-	stateConf := &retry.StateChangeConf{
-		Pending:    []string{"INITIATING", "DELETING"},
-		Target:     []string{"WAITING_FOR_USER", "FAILED", "DELETED", "AVAILABLE"},
-		Refresh:    resourcePrivateLinkEndpointRefreshFunc(ctx, conn, projectID, providerName, privateEndpointConn.ID),
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		MinTimeout: 5 * time.Second,
-		Delay:      3 * time.Second,
-	}
+		// the endpoint is kept in the state even if it fails, so its details can be inspected and it's replaced on the next apply
+		d.SetId(encodeStateID(map[string]string{
+			"private_link_id": privateEndpointConn.ID,
+			"project_id":      projectID,
+			"provider_name":   providerName,
+			"region":          region,
+		}))
+
+		stateConf := &retry.StateChangeConf{
+			Pending:    []string{"INITIATING", "DELETING"},
+			Target:     []string{"WAITING_FOR_USER", "DELETED", "AVAILABLE"},
+			Refresh:    resourcePrivateLinkEndpointCreateRefreshFunc(ctx, conn, projectID, providerName, privateEndpointConn.ID),
+			Timeout:    d.Timeout(schema.TimeoutCreate),
+			MinTimeout: 5 * time.Second,
+			Delay:      3 * time.Second,
+		}
 
-	// Wait, catching any errors
-	_, err = stateConf.WaitForStateContext(ctx)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf(errorPrivateLinkEndpointsCreate, err))
-	}
+		// Wait, catching any errors
+		_, err = stateConf.WaitForStateContext(ctx)
+		if err == nil {
+			break
+		}
 
-	d.SetId(encodeStateID(map[string]string{
-		"private_link_id": privateEndpointConn.ID,
-		"project_id":      projectID,
-		"provider_name":   providerName,
-		"region":          region,
-	}))
+		var failedErr *privateEndpointFailedError
+		if !errors.As(err, &failedErr) || attempt >= retries {
+			diags := resourceMongoDBAtlasPrivateLinkEndpointRead(ctx, d, meta)
+			return append(diags, privateEndpointCreateDiagnostic("error creating MongoDB Private Endpoints Connection", err))
+		}
+
+		log.Printf("[WARN] %s, recreating it (retry %d of %d)", failedErr, attempt+1, retries)
+		if err := deleteFailedPrivateLinkEndpoint(ctx, conn, projectID, providerName, privateEndpointConn.ID, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(fmt.Errorf(errorPrivateLinkEndpointsCreate, err))
+		}
+	}
 
 	return resourceMongoDBAtlasPrivateLinkEndpointRead(ctx, d, meta)
 }
@@ -227,6 +249,11 @@ func resourceMongoDBAtlasPrivateLinkEndpointRead(ctx context.Context, d *schema.
 	return nil
 }
 
+func resourceMongoDBAtlasPrivateLinkEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// only retries_on_failure can be updated, and it's only used on create
+	return resourceMongoDBAtlasPrivateLinkEndpointRead(ctx, d, meta)
+}
+
 func resourceMongoDBAtlasPrivateLinkEndpointDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*MongoDBClient).Atlas
 
@@ -292,6 +319,10 @@ func resourceMongoDBAtlasPrivateLinkEndpointImportState(ctx context.Context, d *
 		log.Printf(errorPrivateLinkEndpointsSetting, "project_id", privateLinkID, err)
 	}
 
+	if err := d.Set("retries_on_failure", 0); err != nil {
+		log.Printf(errorPrivateLinkEndpointsSetting, "retries_on_failure", privateLinkID, err)
+	}
+
 	d.SetId(encodeStateID(map[string]string{
 		"private_link_id": privateEndpoint.ID,
 		"project_id":      projectID,
@@ -313,10 +344,124 @@ func resourcePrivateLinkEndpointRefreshFunc(ctx context.Context, client *matlas.
 			return nil, "REJECTED", err
 		}
 
-		if p.Status != "WAITING_FOR_USER" {
-			return "", p.Status, nil
+		return p, p.Status, nil
+	}
+}
+
+// resourcePrivateLinkEndpointCreateRefreshFunc returns a privateEndpointFailedError if the endpoint fails while it's
+// being created, instead of considering FAILED a target state.
+func resourcePrivateLinkEndpointCreateRefreshFunc(ctx context.Context, client *matlas.Client, projectID, providerName, privateLinkID string) retry.StateRefreshFunc {
+	refresh := resourcePrivateLinkEndpointRefreshFunc(ctx, client, projectID, providerName, privateLinkID)
+	return func() (interface{}, string, error) {
+		result, state, err := refresh()
+		if err != nil {
+			return result, state, err
 		}
 
-		return p, p.Status, nil
+		if p, ok := result.(*matlas.PrivateEndpointConnection); ok && isPrivateEndpointFailed(state) {
+			return nil, state, &privateEndpointFailedError{
+				description:  fmt.Sprintf("MongoDB Private Endpoints Connection (%s)", privateLinkID),
+				statusAttr:   "status",
+				status:       state,
+				errorMessage: p.ErrorMessage,
+				remediation:  privateLinkEndpointRemediation(providerName, p.Region),
+			}
+		}
+
+		return result, state, nil
+	}
+}
+
+// deleteFailedPrivateLinkEndpoint deletes an endpoint that failed to be created and waits until it's gone, so a new
+// one can be created in the same region.
+func deleteFailedPrivateLinkEndpoint(ctx context.Context, client *matlas.Client, projectID, providerName, privateLinkID string, timeout time.Duration) error {
+	resp, err := client.PrivateEndpoints.Delete(ctx, projectID, providerName, privateLinkID)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil
+		}
+
+		return err
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{"INITIATING", "WAITING_FOR_USER", "FAILED", "AVAILABLE", "DELETING"},
+		Target:     []string{"DELETED"},
+		Refresh:    resourcePrivateLinkEndpointRefreshFunc(ctx, client, projectID, providerName, privateLinkID),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+		Delay:      3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+// privateEndpointFailedError is returned when a private endpoint, or its connection, ends in the FAILED or REJECTED
+// state while it's being created.
+type privateEndpointFailedError struct {
+	description  string
+	statusAttr   string
+	status       string
+	errorMessage string
+	remediation  string
+}
+
+func (e *privateEndpointFailedError) Error() string {
+	if e.errorMessage == "" {
+		return fmt.Sprintf("%s is in state %s (`%s`)", e.description, e.status, e.statusAttr)
+	}
+
+	return fmt.Sprintf("%s is in state %s (`%s`): %s", e.description, e.status, e.statusAttr, e.errorMessage)
+}
+
+// retryable reports whether recreating the endpoint can fix the failure. A REJECTED endpoint was refused in the
+// cloud provider, so it would be rejected again until the cause is fixed there.
+func (e *privateEndpointFailedError) retryable() bool {
+	return e.status == "FAILED"
+}
+
+func isPrivateEndpointFailed(status string) bool {
+	return status == "FAILED" || status == "REJECTED"
+}
+
+// privateEndpointCreateDiagnostic returns the diagnostic of an error waiting for a private endpoint, with the
+// remediation hints if the endpoint failed.
+func privateEndpointCreateDiagnostic(summary string, err error) diag.Diagnostic {
+	var failedErr *privateEndpointFailedError
+	if !errors.As(err, &failedErr) {
+		return diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  summary,
+			Detail:   err.Error(),
+		}
+	}
+
+	detail := fmt.Sprintf("%s\n\nThe resource is kept in the state as tainted and will be replaced on the next apply.", failedErr.remediation)
+	if failedErr.retryable() {
+		detail += " Set `retries_on_failure` to let the provider delete and recreate it automatically."
+	}
+
+	return diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  failedErr.Error(),
+		Detail:   detail,
+	}
+}
+
+func privateLinkEndpointRemediation(providerName, region string) string {
+	switch providerName {
+	case "AWS":
+		return fmt.Sprintf("Atlas couldn't create the AWS PrivateLink endpoint service in region %s. Check that the region is supported by Atlas "+
+			"and that the project doesn't already have an endpoint service in that region.", region)
+	case "AZURE":
+		return fmt.Sprintf("Atlas couldn't create the Azure Private Link Service in region %s. Check that the region is supported by Atlas "+
+			"and that the project doesn't already have a Private Link Service in that region.", region)
+	case "GCP":
+		return fmt.Sprintf("Atlas couldn't create the GCP Private Service Connect endpoint service in region %s. Check that the region is supported by Atlas, "+
+			"and that the project limits `atlas.project.deployment.privateServiceConnectionsPerRegionGroup` and "+
+			"`atlas.project.deployment.privateServiceConnectionsSubnetMask` allow a new endpoint service.", region)
+	default:
+		return fmt.Sprintf("Atlas couldn't create the endpoint service in region %s.", region)
 	}
 }
@@ -28,6 +28,7 @@ func resourceMongoDBAtlasPrivateEndpointServiceLink() *schema.Resource {
 	return &schema.Resource{
 		CreateContext:      resourceMongoDBAtlasPrivateEndpointServiceLinkCreate,
 		ReadWithoutTimeout: resourceMongoDBAtlasPrivateEndpointServiceLinkRead,
+		UpdateContext:      resourceMongoDBAtlasPrivateEndpointServiceLinkUpdate,
 		DeleteContext:      resourceMongoDBAtlasPrivateEndpointServiceLinkDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceMongoDBAtlasPrivateEndpointServiceLinkImportState,
@@ -54,6 +55,12 @@ func resourceMongoDBAtlasPrivateEndpointServiceLink() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"retries_on_failure": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(0, 5),
+			},
 			"interface_endpoint_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -166,23 +173,45 @@ func resourceMongoDBAtlasPrivateEndpointServiceLinkCreate(ctx context.Context, d
 		request.Endpoints = expandGCPEndpoints(e.([]interface{}))
 	}
 
-	_, _, err := conn.PrivateEndpoints.AddOnePrivateEndpoint(ctx, projectID, providerName, privateLinkID, request)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf(errorServiceEndpointAdd, providerName, privateLinkID, err))
-	}
+	retries := d.Get("retries_on_failure").(int)
+	for attempt := 0; ; attempt++ {
+		_, _, err := conn.PrivateEndpoints.AddOnePrivateEndpoint(ctx, projectID, providerName, privateLinkID, request)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf(errorServiceEndpointAdd, providerName, privateLinkID, err))
+		}
 
-	stateConf := &retry.StateChangeConf{
-		Pending:    []string{"NONE", "INITIATING", "PENDING_ACCEPTANCE", "PENDING", "DELETING", "VERIFIED"},
-		Target:     []string{"AVAILABLE", "REJECTED", "DELETED", "FAILED"},
-		Refresh:    resourceServiceEndpointRefreshFunc(ctx, conn, projectID, providerName, privateLinkID, endpointServiceID),
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		MinTimeout: 5 * time.Second,
-		Delay:      5 * time.Minute,
-	}
-	// Wait, catching any errors
-	_, err = stateConf.WaitForStateContext(ctx)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf(errorServiceEndpointAdd, endpointServiceID, privateLinkID, err))
+		// the endpoint is kept in the state even if it fails, so its details can be inspected and it's replaced on the next apply
+		d.SetId(encodeStateID(map[string]string{
+			"project_id":          projectID,
+			"private_link_id":     privateLinkID,
+			"endpoint_service_id": endpointServiceID,
+			"provider_name":       providerName,
+		}))
+
+		stateConf := &retry.StateChangeConf{
+			Pending:    []string{"NONE", "INITIATING", "PENDING_ACCEPTANCE", "PENDING", "DELETING", "VERIFIED"},
+			Target:     []string{"AVAILABLE", "DELETED"},
+			Refresh:    resourceServiceEndpointCreateRefreshFunc(ctx, conn, projectID, providerName, privateLinkID, endpointServiceID),
+			Timeout:    d.Timeout(schema.TimeoutCreate),
+			MinTimeout: 5 * time.Second,
+			Delay:      5 * time.Minute,
+		}
+		// Wait, catching any errors
+		_, err = stateConf.WaitForStateContext(ctx)
+		if err == nil {
+			break
+		}
+
+		var failedErr *privateEndpointFailedError
+		if !errors.As(err, &failedErr) || !failedErr.retryable() || attempt >= retries {
+			diags := resourceMongoDBAtlasPrivateEndpointServiceLinkRead(ctx, d, meta)
+			return append(diags, privateEndpointCreateDiagnostic(fmt.Sprintf("error adding MongoDB Private Service Endpoint Connection(%s) to a Private Endpoint (%s)", endpointServiceID, privateLinkID), err))
+		}
+
+		log.Printf("[WARN] %s, recreating it (retry %d of %d)", failedErr, attempt+1, retries)
+		if err := deleteFailedPrivateEndpointServiceLink(ctx, conn, projectID, providerName, privateLinkID, endpointServiceID, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(fmt.Errorf(errorServiceEndpointAdd, endpointServiceID, privateLinkID, err))
+		}
 	}
 
 	clusterConf := &retry.StateChangeConf{
@@ -194,18 +223,11 @@ func resourceMongoDBAtlasPrivateEndpointServiceLinkCreate(ctx context.Context, d
 		Delay:      5 * time.Minute,
 	}
 
-	if _, err = clusterConf.WaitForStateContext(ctx); err != nil {
+	if _, err := clusterConf.WaitForStateContext(ctx); err != nil {
 		// error awaiting advanced clusters IDLE should not result in failure to apply changes to this resource
 		log.Printf(errorAdvancedClusterListStatus, err)
 	}
 
-	d.SetId(encodeStateID(map[string]string{
-		"project_id":          projectID,
-		"private_link_id":     privateLinkID,
-		"endpoint_service_id": endpointServiceID,
-		"provider_name":       providerName,
-	}))
-
 	return resourceMongoDBAtlasPrivateEndpointServiceLinkRead(ctx, d, meta)
 }
 
@@ -279,6 +301,11 @@ func resourceMongoDBAtlasPrivateEndpointServiceLinkRead(ctx context.Context, d *
 	return nil
 }
 
+func resourceMongoDBAtlasPrivateEndpointServiceLinkUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// only retries_on_failure can be updated, and it's only used on create
+	return resourceMongoDBAtlasPrivateEndpointServiceLinkRead(ctx, d, meta)
+}
+
 func resourceMongoDBAtlasPrivateEndpointServiceLinkDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*MongoDBClient).Atlas
 
@@ -361,6 +388,10 @@ func resourceMongoDBAtlasPrivateEndpointServiceLinkImportState(ctx context.Conte
 		return nil, fmt.Errorf(errorEndpointSetting, "provider_name", privateLinkID, err)
 	}
 
+	if err := d.Set("retries_on_failure", 0); err != nil {
+		return nil, fmt.Errorf(errorEndpointSetting, "retries_on_failure", privateLinkID, err)
+	}
+
 	d.SetId(encodeStateID(map[string]string{
 		"project_id":          projectID,
 		"private_link_id":     privateLinkID,
@@ -383,19 +414,92 @@ func resourceServiceEndpointRefreshFunc(ctx context.Context, client *matlas.Clie
 		}
 
 		if strings.EqualFold(providerName, "azure") || strings.EqualFold(providerName, "gcp") {
-			if i.Status != "AVAILABLE" {
-				return "", i.Status, nil
-			}
 			return i, i.Status, nil
 		}
-		if i.AWSConnectionStatus != "AVAILABLE" {
-			return "", i.AWSConnectionStatus, nil
-		}
 
 		return i, i.AWSConnectionStatus, nil
 	}
 }
 
+// resourceServiceEndpointCreateRefreshFunc returns a privateEndpointFailedError if the endpoint is rejected or fails
+// while it's being added, instead of considering REJECTED and FAILED target states.
+func resourceServiceEndpointCreateRefreshFunc(ctx context.Context, client *matlas.Client, projectID, providerName, privateLinkID, endpointServiceID string) retry.StateRefreshFunc {
+	refresh := resourceServiceEndpointRefreshFunc(ctx, client, projectID, providerName, privateLinkID, endpointServiceID)
+	return func() (interface{}, string, error) {
+		result, state, err := refresh()
+		if err != nil {
+			return result, state, err
+		}
+
+		if i, ok := result.(*matlas.InterfaceEndpointConnection); ok && isPrivateEndpointFailed(state) {
+			return nil, state, &privateEndpointFailedError{
+				description:  fmt.Sprintf("MongoDB Private Service Endpoint Connection (%s)", endpointServiceID),
+				statusAttr:   serviceEndpointStatusAttribute(providerName),
+				status:       state,
+				errorMessage: i.ErrorMessage,
+				remediation:  serviceEndpointRemediation(providerName),
+			}
+		}
+
+		return result, state, nil
+	}
+}
+
+// deleteFailedPrivateEndpointServiceLink removes an endpoint that failed to be added and waits until it's gone, so
+// it can be added again.
+func deleteFailedPrivateEndpointServiceLink(ctx context.Context, client *matlas.Client, projectID, providerName, privateLinkID, endpointServiceID string, timeout time.Duration) error {
+	resp, err := client.PrivateEndpoints.DeleteOnePrivateEndpoint(ctx, projectID, providerName, privateLinkID, endpointServiceID)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil
+		}
+
+		return err
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{"NONE", "INITIATING", "PENDING_ACCEPTANCE", "PENDING", "VERIFIED", "AVAILABLE", "REJECTED", "FAILED", "DELETING"},
+		Target:     []string{"DELETED"},
+		Refresh:    resourceServiceEndpointRefreshFunc(ctx, client, projectID, providerName, privateLinkID, endpointServiceID),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+		Delay:      3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func serviceEndpointStatusAttribute(providerName string) string {
+	switch providerName {
+	case "AZURE":
+		return "azure_status"
+	case "GCP":
+		return "gcp_status"
+	default:
+		return "aws_connection_status"
+	}
+}
+
+func serviceEndpointRemediation(providerName string) string {
+	switch providerName {
+	case "AWS":
+		return "Check in AWS that the VPC interface endpoint `endpoint_service_id` exists in the region of the private link, " +
+			"that it uses the `endpoint_service_name` of the `mongodbatlas_privatelink_endpoint`, and that it wasn't rejected or deleted. " +
+			"A rejected VPC interface endpoint can't be accepted again, so recreate it in AWS."
+	case "AZURE":
+		return "Check in Azure that the private endpoint `endpoint_service_id` connects to the `private_link_service_resource_id` " +
+			"of the `mongodbatlas_privatelink_endpoint` with a manual approval request, and that `private_endpoint_ip_address` " +
+			"is the private IP address of its network interface."
+	case "GCP":
+		return "Check in GCP that the forwarding rules of `endpoints` exist in `gcp_project_id`, that each of them targets the " +
+			"matching `service_attachment_names` of the `mongodbatlas_privatelink_endpoint`, and that their names and IP addresses " +
+			"match the configuration."
+	default:
+		return ""
+	}
+}
+
 func expandGCPEndpoint(tfMap map[string]interface{}) *matlas.GCPEndpoint {
 	if tfMap == nil {
 		return nil
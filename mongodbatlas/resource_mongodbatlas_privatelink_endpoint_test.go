@@ -2,10 +2,13 @@ package mongodbatlas
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -173,6 +176,68 @@ func TestAccNetworkRSPrivateLinkEndpointGCP_basic(t *testing.T) {
 	})
 }
 
+func TestPrivateEndpointCreateDiagnostic(t *testing.T) {
+	failedErr := &privateEndpointFailedError{
+		description:  "MongoDB Private Service Endpoint Connection (vpce-123)",
+		statusAttr:   "aws_connection_status",
+		status:       "REJECTED",
+		errorMessage: "the VPC endpoint was rejected",
+		remediation:  serviceEndpointRemediation("AWS"),
+	}
+	failedStatusErr := &privateEndpointFailedError{
+		description: "MongoDB Private Endpoint (pl-123)",
+		statusAttr:  "status",
+		status:      "FAILED",
+		remediation: privateLinkEndpointRemediation("AWS", "us-east-1"),
+	}
+
+	testCases := []struct {
+		name            string
+		err             error
+		expectedSummary string
+		expectedDetail  string
+		retryHint       bool
+	}{
+		{
+			name:            "rejected endpoint",
+			err:             fmt.Errorf("waiting: %w", failedErr),
+			expectedSummary: "MongoDB Private Service Endpoint Connection (vpce-123) is in state REJECTED (`aws_connection_status`): the VPC endpoint was rejected",
+			expectedDetail:  "A rejected VPC interface endpoint can't be accepted again",
+		},
+		{
+			name:            "failed endpoint",
+			err:             fmt.Errorf("waiting: %w", failedStatusErr),
+			expectedSummary: "MongoDB Private Endpoint (pl-123) is in state FAILED (`status`)",
+			expectedDetail:  "us-east-1",
+			retryHint:       true,
+		},
+		{
+			name:            "other error",
+			err:             errors.New("timeout while waiting for state to become 'AVAILABLE'"),
+			expectedSummary: "error adding endpoint",
+			expectedDetail:  "timeout while waiting",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := privateEndpointCreateDiagnostic("error adding endpoint", tc.err)
+			if got.Severity != diag.Error {
+				t.Errorf("expected an error diagnostic, got severity %v", got.Severity)
+			}
+			if got.Summary != tc.expectedSummary {
+				t.Errorf("expected summary %q, got %q", tc.expectedSummary, got.Summary)
+			}
+			if !strings.Contains(got.Detail, tc.expectedDetail) {
+				t.Errorf("expected detail to contain %q, got %q", tc.expectedDetail, got.Detail)
+			}
+			if hasHint := strings.Contains(got.Detail, "retries_on_failure"); hasHint != tc.retryHint {
+				t.Errorf("expected retries_on_failure hint %t, got detail %q", tc.retryHint, got.Detail)
+			}
+		})
+	}
+}
+
 func testAccCheckMongoDBAtlasPrivateLinkEndpointImportStateIDFunc(resourceName string) resource.ImportStateIdFunc {
 	return func(s *terraform.State) (string, error) {
 		rs, ok := s.RootModule().Resources[resourceName]
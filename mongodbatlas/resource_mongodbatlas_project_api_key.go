@@ -22,6 +22,7 @@ func resourceMongoDBAtlasProjectAPIKey() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceMongoDBAtlasProjectAPIKeyImportState,
 		},
+		CustomizeDiff: resourceAPIKeyAccessListCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"project_id": {
 				Type:     schema.TypeString,
@@ -63,6 +64,12 @@ func resourceMongoDBAtlasProjectAPIKey() *schema.Resource {
 					},
 				},
 			},
+			"access_list": apiKeyAccessListSchema(),
+			"rotation_trigger": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
 		},
 	}
 }
@@ -82,34 +89,52 @@ func resourceMongoDBAtlasProjectAPIKeyCreate(ctx context.Context, d *schema.Reso
 	var resp *matlas.Response
 
 	createRequest.Desc = d.Get("description").(string)
-	if projectAssignments, ok := d.GetOk("project_assignment"); ok {
-		projectAssignmentList := ExpandProjectAssignmentSet(projectAssignments.(*schema.Set))
-		for _, apiKeyList := range projectAssignmentList {
-			if apiKeyList.ProjectID == projectID {
-				createRequest.Roles = apiKeyList.RoleNames
-				apiKey, resp, err = conn.ProjectAPIKeys.Create(ctx, projectID, createRequest)
-				if err != nil {
-					if resp != nil && resp.StatusCode == http.StatusNotFound {
-						d.SetId("")
-						return nil
-					}
+	projectAssignmentList := ExpandProjectAssignmentSet(d.Get("project_assignment").(*schema.Set))
+	for _, apiKeyList := range projectAssignmentList {
+		if apiKeyList.ProjectID == projectID {
+			createRequest.Roles = apiKeyList.RoleNames
+			apiKey, resp, err = conn.ProjectAPIKeys.Create(ctx, projectID, createRequest)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					d.SetId("")
+					return nil
 				}
+				return diag.FromErr(fmt.Errorf("error creating API key: %s", err))
 			}
 		}
+	}
 
-		for _, apiKeyList := range projectAssignmentList {
-			if apiKeyList.ProjectID != projectID {
-				createRequest.Roles = apiKeyList.RoleNames
-				_, err := conn.ProjectAPIKeys.Assign(ctx, apiKeyList.ProjectID, apiKey.ID, &matlas.AssignAPIKey{
-					Roles: createRequest.Roles,
-				})
-				if err != nil {
-					if resp != nil && resp.StatusCode == http.StatusNotFound {
-						d.SetId("")
-						return nil
-					}
+	if apiKey == nil {
+		return diag.FromErr(fmt.Errorf("error creating API key: `project_assignment` must include the project (%s)", projectID))
+	}
+
+	for _, apiKeyList := range projectAssignmentList {
+		if apiKeyList.ProjectID != projectID {
+			createRequest.Roles = apiKeyList.RoleNames
+			resp, err = conn.ProjectAPIKeys.Assign(ctx, apiKeyList.ProjectID, apiKey.ID, &matlas.AssignAPIKey{
+				Roles: createRequest.Roles,
+			})
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					d.SetId("")
+					return nil
 				}
+				return diag.FromErr(fmt.Errorf("error assigning API key (%s) to project (%s): %s", apiKey.ID, apiKeyList.ProjectID, err))
+			}
+		}
+	}
+
+	// the key is removed if its access list can't be created, so it's never left without the expected restrictions
+	if accessList := d.Get("access_list").(*schema.Set); accessList.Len() > 0 {
+		orgID, err := getProjectOrgID(ctx, conn, projectID)
+		if err == nil {
+			err = createAPIKeyAccessList(ctx, conn, orgID, apiKey.ID, accessList)
+		}
+		if err != nil {
+			if errDelete := deleteProjectAPIKey(ctx, conn, projectID, apiKey.ID); errDelete != nil {
+				return diag.FromErr(fmt.Errorf("%s, and the API key (%s) couldn't be removed: %s", err, apiKey.ID, errDelete))
 			}
+			return diag.FromErr(err)
 		}
 	}
 
@@ -171,6 +196,18 @@ func resourceMongoDBAtlasProjectAPIKeyRead(ctx context.Context, d *schema.Resour
 		return nil
 	}
 
+	orgID, err := getProjectOrgID(ctx, conn, projectID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	accessList, err := flattenAPIKeyAccessList(ctx, conn, orgID, apiKeyID, d.Get("access_list").(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("access_list", accessList); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting `access_list`: %s", err))
+	}
+
 	if err := d.Set("project_id", projectID); err != nil {
 		return diag.FromErr(fmt.Errorf("error setting `project_id`: %s", err))
 	}
@@ -251,6 +288,16 @@ func resourceMongoDBAtlasProjectAPIKeyUpdate(ctx context.Context, d *schema.Reso
 		}
 	}
 
+	if d.HasChange("access_list") {
+		orgID, err := getProjectOrgID(ctx, conn, projectID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := updateAPIKeyAccessList(ctx, conn, orgID, apiKeyID, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceMongoDBAtlasProjectAPIKeyRead(ctx, d, meta)
 }
 
@@ -464,3 +511,24 @@ func getAPIProjectAssignments(ctx context.Context, conn *matlas.Client, apiKeyOr
 	}
 	return projectAssignments, nil
 }
+
+func getProjectOrgID(ctx context.Context, conn *matlas.Client, projectID string) (string, error) {
+	project, _, err := conn.Projects.GetOneProject(ctx, projectID)
+	if err != nil {
+		return "", fmt.Errorf(errorProjectRead, projectID, err)
+	}
+	return project.OrgID, nil
+}
+
+// deleteProjectAPIKey removes a key that was just created in the project, unassigning it before deleting it from the organization.
+func deleteProjectAPIKey(ctx context.Context, conn *matlas.Client, projectID, apiKeyID string) error {
+	orgID, err := getProjectOrgID(ctx, conn, projectID)
+	if err != nil {
+		return err
+	}
+	if _, err := conn.ProjectAPIKeys.Unassign(ctx, projectID, apiKeyID); err != nil {
+		return err
+	}
+	_, err = conn.APIKeys.Delete(ctx, orgID, apiKeyID)
+	return err
+}
@@ -39,6 +39,38 @@ func TestAccConfigRSProjectAPIKey_Basic(t *testing.T) {
 	})
 }
 
+func TestAccConfigRSProjectAPIKey_AccessList(t *testing.T) {
+	var (
+		resourceName = "mongodbatlas_project_api_key.test"
+		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		projectName  = acctest.RandomWithPrefix("test-acc")
+		description  = fmt.Sprintf("test-acc-project-api_key-%s", acctest.RandString(5))
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasProjectAPIKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasProjectAPIKeyConfigAccessList(orgID, projectName, description, "179.154.226.10", "179.154.228.0/24"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "access_list.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "access_list.*", map[string]string{"ip_address": "179.154.226.10"}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "access_list.*", map[string]string{"cidr_block": "179.154.228.0/24"}),
+				),
+			},
+			{
+				Config: testAccMongoDBAtlasProjectAPIKeyConfigAccessList(orgID, projectName, description, "179.154.226.11", "179.154.228.0/24"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "access_list.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "access_list.*", map[string]string{"ip_address": "179.154.226.11"}),
+				),
+			},
+		},
+	})
+}
+
 func TestAccConfigRSProjectAPIKey_Multiple(t *testing.T) {
 	var (
 		resourceName    = "mongodbatlas_project_api_key.test"
@@ -268,3 +300,26 @@ func testAccMongoDBAtlasProjectAPIKeyConfigMultiple(orgID, projectName, descript
 		}
 	`, orgID, projectName, description, roleNames)
 }
+
+func testAccMongoDBAtlasProjectAPIKeyConfigAccessList(orgID, projectName, description, ipAddress, cidrBlock string) string {
+	return fmt.Sprintf(`
+		resource "mongodbatlas_project" "test" {
+			name   = %[2]q
+			org_id = %[1]q
+		}
+		resource "mongodbatlas_project_api_key" "test" {
+			project_id  = mongodbatlas_project.test.id
+			description = %[3]q
+			project_assignment {
+				project_id = mongodbatlas_project.test.id
+				role_names = ["GROUP_OWNER"]
+			}
+			access_list {
+				ip_address = %[4]q
+			}
+			access_list {
+				cidr_block = %[5]q
+			}
+		}
+	`, orgID, projectName, description, ipAddress, cidrBlock)
+}
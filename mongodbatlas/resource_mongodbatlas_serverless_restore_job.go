@@ -0,0 +1,321 @@
+package mongodbatlas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	atlasSDK "go.mongodb.org/atlas-sdk/v20230201006/admin"
+)
+
+const (
+	errorServerlessRestoreJobCreate  = "error creating a restore job for serverless instance (%s): %s"
+	errorServerlessRestoreJobRead    = "error getting restore job (%s) of serverless instance (%s): %s"
+	errorServerlessRestoreJobSetting = "error setting `%s` for serverless restore job (%s): %s"
+)
+
+func resourceMongoDBAtlasServerlessRestoreJob() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceMongoDBAtlasServerlessRestoreJobCreate,
+		ReadContext:   resourceMongoDBAtlasServerlessRestoreJobRead,
+		UpdateContext: resourceMongoDBAtlasServerlessRestoreJobUpdate,
+		DeleteContext: schema.NoopContext,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceMongoDBAtlasServerlessRestoreJobImportState,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(3 * time.Hour),
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"instance_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"snapshot_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"delivery_type_config": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Required: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"automated": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"point_in_time": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"target_cluster_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"target_project_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"oplog_ts": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+						"point_in_time_utc_seconds": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+						"oplog_inc": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"wait_for_completion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"restore_job_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"delivery_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cancelled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"expired": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"failed": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"expires_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"finished_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceMongoDBAtlasServerlessRestoreJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*MongoDBClient).AtlasV2
+	projectID := d.Get("project_id").(string)
+	instanceName := d.Get("instance_name").(string)
+
+	deliveryTypeConfig := d.Get("delivery_type_config").([]interface{})
+	if err := validateDeliveryType(deliveryTypeConfig); err != nil {
+		return diag.FromErr(err)
+	}
+
+	restoreJobReq := expandServerlessRestoreJob(deliveryTypeConfig[0].(map[string]interface{}), d.Get("snapshot_id").(string))
+	if restoreJobReq.SnapshotId == nil && restoreJobReq.DeliveryType != "pointInTime" {
+		return diag.FromErr(errors.New("snapshot_id must be set for automated restore jobs"))
+	}
+
+	restoreJob, _, err := conn.CloudBackupsApi.CreateServerlessBackupRestoreJob(ctx, projectID, instanceName, restoreJobReq).Execute()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf(errorServerlessRestoreJobCreate, instanceName, err))
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"project_id":     projectID,
+		"instance_name":  instanceName,
+		"restore_job_id": restoreJob.GetId(),
+	}))
+
+	if d.Get("wait_for_completion").(bool) {
+		stateConf := &retry.StateChangeConf{
+			Pending:    []string{"pending"},
+			Target:     []string{"completed"},
+			Refresh:    resourceServerlessRestoreJobRefreshFunc(ctx, conn, projectID, instanceName, restoreJob.GetId()),
+			Timeout:    d.Timeout(schema.TimeoutCreate),
+			MinTimeout: 30 * time.Second,
+			Delay:      1 * time.Minute,
+		}
+
+		// the job is kept in the state even if it fails, so its details can be inspected and it's replaced on the next apply
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			diags := resourceMongoDBAtlasServerlessRestoreJobRead(ctx, d, meta)
+			return append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "error waiting for the serverless restore job to complete",
+				Detail:   err.Error(),
+			})
+		}
+	}
+
+	return resourceMongoDBAtlasServerlessRestoreJobRead(ctx, d, meta)
+}
+
+func resourceMongoDBAtlasServerlessRestoreJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*MongoDBClient).AtlasV2
+	ids := decodeStateID(d.Id())
+	restoreJobID := ids["restore_job_id"]
+
+	restoreJob, resp, err := conn.CloudBackupsApi.GetServerlessBackupRestoreJob(ctx, ids["project_id"], ids["instance_name"], restoreJobID).Execute()
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+
+		return diag.FromErr(fmt.Errorf(errorServerlessRestoreJobRead, restoreJobID, ids["instance_name"], err))
+	}
+
+	values := flattenServerlessRestoreJob(restoreJob)
+	for _, key := range []string{"snapshot_id", "restore_job_id", "delivery_type", "cancelled", "expired", "failed", "expires_at", "finished_at", "timestamp"} {
+		if err := d.Set(key, values[key]); err != nil {
+			return diag.FromErr(fmt.Errorf(errorServerlessRestoreJobSetting, key, restoreJobID, err))
+		}
+	}
+
+	return nil
+}
+
+func resourceMongoDBAtlasServerlessRestoreJobUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceMongoDBAtlasServerlessRestoreJobRead(ctx, d, meta)
+}
+
+func resourceMongoDBAtlasServerlessRestoreJobImportState(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*MongoDBClient).AtlasV2
+
+	parts := regexp.MustCompile(`(?s)^([0-9a-fA-F]{24})-(.*)-([0-9a-fA-F]{24})$`).FindStringSubmatch(d.Id())
+	if len(parts) != 4 {
+		return nil, errors.New("import format error: to import a serverless restore job, use the format {project_id}-{instance_name}-{restore_job_id}")
+	}
+	projectID, instanceName, restoreJobID := parts[1], parts[2], parts[3]
+
+	restoreJob, _, err := conn.CloudBackupsApi.GetServerlessBackupRestoreJob(ctx, projectID, instanceName, restoreJobID).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("couldn't import restore job (%s) of serverless instance (%s) in project (%s), error: %s", restoreJobID, instanceName, projectID, err)
+	}
+
+	if err := d.Set("project_id", projectID); err != nil {
+		log.Printf("[WARN] Error setting project_id for (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("instance_name", instanceName); err != nil {
+		log.Printf("[WARN] Error setting instance_name for (%s): %s", d.Id(), err)
+	}
+
+	deliveryTypeConfig := map[string]interface{}{
+		"automated":                 restoreJob.GetDeliveryType() == "automated",
+		"point_in_time":             restoreJob.GetDeliveryType() == "pointInTime",
+		"target_cluster_name":       restoreJob.GetTargetClusterName(),
+		"target_project_id":         restoreJob.GetTargetGroupId(),
+		"oplog_ts":                  restoreJob.GetOplogTs(),
+		"oplog_inc":                 restoreJob.GetOplogInc(),
+		"point_in_time_utc_seconds": restoreJob.GetPointInTimeUTCSeconds(),
+	}
+	if err := d.Set("delivery_type_config", []interface{}{deliveryTypeConfig}); err != nil {
+		log.Printf("[WARN] Error setting delivery_type_config for (%s): %s", d.Id(), err)
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"project_id":     projectID,
+		"instance_name":  instanceName,
+		"restore_job_id": restoreJobID,
+	}))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceServerlessRestoreJobRefreshFunc(ctx context.Context, conn *atlasSDK.APIClient, projectID, instanceName, restoreJobID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		restoreJob, _, err := conn.CloudBackupsApi.GetServerlessBackupRestoreJob(ctx, projectID, instanceName, restoreJobID).Execute()
+		if err != nil {
+			return nil, "failed", err
+		}
+
+		status := serverlessRestoreJobStatus(restoreJob)
+		log.Printf("[DEBUG] status for restore job %s of serverless instance %s: %s", restoreJobID, instanceName, status)
+
+		switch status {
+		case "failed", "cancelled", "expired":
+			return nil, status, fmt.Errorf("restore job (%s) of serverless instance (%s) is %s, delivery type: %s, target cluster: %s",
+				restoreJobID, instanceName, status, restoreJob.GetDeliveryType(), restoreJob.GetTargetClusterName())
+		}
+
+		return restoreJob, status, nil
+	}
+}
+
+func serverlessRestoreJobStatus(restoreJob *atlasSDK.ServerlessBackupRestoreJob) string {
+	switch {
+	case restoreJob.GetFailed():
+		return "failed"
+	case restoreJob.GetCancelled():
+		return "cancelled"
+	case restoreJob.GetExpired():
+		return "expired"
+	case restoreJob.FinishedAt != nil:
+		return "completed"
+	default:
+		return "pending"
+	}
+}
+
+func expandServerlessRestoreJob(deliveryTypeConfig map[string]interface{}, snapshotID string) *atlasSDK.ServerlessBackupRestoreJob {
+	restoreJob := &atlasSDK.ServerlessBackupRestoreJob{
+		DeliveryType:      "automated",
+		TargetClusterName: deliveryTypeConfig["target_cluster_name"].(string),
+		TargetGroupId:     deliveryTypeConfig["target_project_id"].(string),
+	}
+
+	if snapshotID != "" {
+		restoreJob.SnapshotId = &snapshotID
+	}
+
+	if pointInTime, _ := deliveryTypeConfig["point_in_time"].(bool); pointInTime {
+		restoreJob.DeliveryType = "pointInTime"
+		if v := deliveryTypeConfig["point_in_time_utc_seconds"].(int); v > 0 {
+			restoreJob.PointInTimeUTCSeconds = &v
+		}
+		if v := deliveryTypeConfig["oplog_ts"].(int); v > 0 {
+			restoreJob.OplogTs = &v
+		}
+		if v := deliveryTypeConfig["oplog_inc"].(int); v > 0 {
+			restoreJob.OplogInc = &v
+		}
+	}
+
+	return restoreJob
+}
@@ -0,0 +1,87 @@
+package mongodbatlas
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBackupDSServerlessSnapshotsAndRestoreJobs_basic(t *testing.T) {
+	var (
+		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		projectName  = acctest.RandomWithPrefix("test-acc-serverless")
+		instanceName = acctest.RandomWithPrefix("test-acc-serverless")
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasServerlessInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasServerlessSnapshotsDataSourceConfig(orgID, projectName, instanceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.mongodbatlas_serverless_snapshots.test", "total_count"),
+					resource.TestCheckResourceAttrSet("data.mongodbatlas_serverless_restore_jobs.test", "total_count"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBackupRSServerlessRestoreJob_missingSnapshotID(t *testing.T) {
+	var (
+		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		projectName  = acctest.RandomWithPrefix("test-acc-serverless")
+		instanceName = acctest.RandomWithPrefix("test-acc-serverless")
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasServerlessInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccMongoDBAtlasServerlessRestoreJobAutomatedConfig(orgID, projectName, instanceName),
+				ExpectError: regexp.MustCompile("snapshot_id must be set for automated restore jobs"),
+			},
+		},
+	})
+}
+
+func testAccMongoDBAtlasServerlessSnapshotsDataSourceConfig(orgID, projectName, instanceName string) string {
+	return fmt.Sprintf(`
+		%[1]s
+
+		data "mongodbatlas_serverless_snapshots" "test" {
+			project_id    = mongodbatlas_serverless_instance.test.project_id
+			instance_name = mongodbatlas_serverless_instance.test.name
+		}
+
+		data "mongodbatlas_serverless_restore_jobs" "test" {
+			project_id    = mongodbatlas_serverless_instance.test.project_id
+			instance_name = mongodbatlas_serverless_instance.test.name
+		}
+	`, testAccMongoDBAtlasServerlessInstanceConfig(orgID, projectName, instanceName, true))
+}
+
+func testAccMongoDBAtlasServerlessRestoreJobAutomatedConfig(orgID, projectName, instanceName string) string {
+	return fmt.Sprintf(`
+		%[1]s
+
+		resource "mongodbatlas_serverless_restore_job" "test" {
+			project_id    = mongodbatlas_serverless_instance.test.project_id
+			instance_name = mongodbatlas_serverless_instance.test.name
+
+			delivery_type_config {
+				automated           = true
+				target_cluster_name = mongodbatlas_serverless_instance.test.name
+				target_project_id   = mongodbatlas_serverless_instance.test.project_id
+			}
+		}
+	`, testAccMongoDBAtlasServerlessInstanceConfig(orgID, projectName, instanceName, true))
+}